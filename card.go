@@ -2,11 +2,12 @@ package goker
 
 import "fmt"
 
-type suit int8
+// Suit is one of the four French suits
+type Suit int8
 
 const (
 	// Spade represents the suit ♠
-	Spade suit = iota
+	Spade Suit = iota
 	// Heart represents the suit ♥
 	Heart
 	// Diamond represents the suit ♦
@@ -15,7 +16,7 @@ const (
 	Club
 )
 
-func (s suit) String() string {
+func (s Suit) String() string {
 	switch s {
 	case Spade:
 		return "♠"
@@ -30,11 +31,58 @@ func (s suit) String() string {
 	}
 }
 
-type rank int8
+// Char returns the ASCII letter for the suit, one of 's', 'h', 'd' or 'c'
+func (s Suit) Char() byte {
+	switch s {
+	case Spade:
+		return 's'
+	case Heart:
+		return 'h'
+	case Diamond:
+		return 'd'
+	case Club:
+		return 'c'
+	default:
+		return '?'
+	}
+}
+
+// Color returns whether the suit is black or red
+func (s Suit) Color() Color {
+	if s == Heart || s == Diamond {
+		return Red
+	}
+	return Black
+}
+
+// AllSuits returns the four suits in order, Spade through Club
+func AllSuits() []Suit {
+	return []Suit{Spade, Heart, Diamond, Club}
+}
+
+// Color is the color of a suit
+type Color int8
+
+const (
+	// Black is the color of spades and clubs
+	Black Color = iota
+	// Red is the color of hearts and diamonds
+	Red
+)
+
+func (c Color) String() string {
+	if c == Red {
+		return "Red"
+	}
+	return "Black"
+}
+
+// Rank is the face value of a card, from Two up to Ace
+type Rank int8
 
 const (
 	// Two represents the rank 2
-	Two rank = iota + 2
+	Two Rank = iota + 2
 	// Three represents the rank 3
 	Three
 	// Four represents the rank 4
@@ -59,9 +107,12 @@ const (
 	King
 	// Ace represents the rank Ace
 	Ace
+	// Joker is the rank of a joker, which has no rank of its own and can
+	// only be played as a wild card
+	Joker
 )
 
-func (r rank) String() string {
+func (r Rank) String() string {
 	switch r {
 	case Ace:
 		return "A"
@@ -73,23 +124,83 @@ func (r rank) String() string {
 		return "J"
 	case Ten:
 		return "T"
+	case Joker:
+		return "X"
 	default:
 		return fmt.Sprintf("%d", int(r))
 	}
 }
 
+// Char returns the ASCII character for the rank, with T standing for Ten
+// and X for Joker
+func (r Rank) Char() byte {
+	return r.String()[0]
+}
+
+// Next returns the rank above the receiver. Aces play both high and
+// low, so the rank after Ace is Two. A joker has no rank of its own to
+// step from, so the rank after Joker is Joker.
+func (r Rank) Next() Rank {
+	switch r {
+	case Ace:
+		return Two
+	case Joker:
+		return Joker
+	}
+	return r + 1
+}
+
+// Prev returns the rank below the receiver. Aces play both high and
+// low, so the rank before Two is Ace. As for Next, the rank before Joker
+// is Joker.
+func (r Rank) Prev() Rank {
+	switch r {
+	case Two:
+		return Ace
+	case Joker:
+		return Joker
+	}
+	return r - 1
+}
+
+// AllRanks returns the thirteen ranks in ascending order, Two through Ace
+func AllRanks() []Rank {
+	ranks := make([]Rank, 0, 13)
+	for r := Two; r <= Ace; r++ {
+		ranks = append(ranks, r)
+	}
+	return ranks
+}
+
 // Card represents a playing card, with a suit and rank
 type Card struct {
-	Rank rank
-	Suit suit
+	Rank Rank
+	Suit Suit
 }
 
 func (c Card) String() string {
+	if c.IsJoker() {
+		return "Joker"
+	}
 	return c.Rank.String() + c.Suit.String()
 }
 
+// IsJoker returns true if the card is a joker
+func (c Card) IsJoker() bool {
+	return c.Rank == Joker
+}
+
 // NewCard constructs a new card of the given suit and rank
-func NewCard(r rank, s suit) *Card {
+func NewCard(r Rank, s Suit) *Card {
 	c := Card{r, s}
 	return &c
 }
+
+// NewJoker constructs a joker of the given color. A joker's suit only
+// tells its color: spades for black and hearts for red.
+func NewJoker(c Color) *Card {
+	if c == Red {
+		return NewCard(Joker, Heart)
+	}
+	return NewCard(Joker, Spade)
+}
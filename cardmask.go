@@ -0,0 +1,176 @@
+package goker
+
+import "math/bits"
+
+// PackedCard is a compact encoding of a card as an index from 0 to 51,
+// thirteen ranks per suit, ordered Two through Ace and Spade through Club.
+// Jokers come after the deck, the black joker at 52 and the red at 53.
+type PackedCard uint8
+
+// Packed encodings of the jokers, which aren't in FullDeckMask
+const (
+	blackJoker PackedCard = 52
+	redJoker   PackedCard = 53
+)
+
+// NewPackedCard returns the packed encoding of the card with the given
+// rank and suit
+func NewPackedCard(r Rank, s Suit) PackedCard {
+	if r == Joker {
+		return blackJoker + PackedCard(s.Color())
+	}
+	return PackedCard(int(s)*13 + int(r-Two))
+}
+
+// Pack returns the packed encoding of the card
+func (c Card) Pack() PackedCard {
+	return NewPackedCard(c.Rank, c.Suit)
+}
+
+// Rank returns the rank of the packed card
+func (p PackedCard) Rank() Rank {
+	if p >= blackJoker {
+		return Joker
+	}
+	return Two + Rank(p%13)
+}
+
+// Suit returns the suit of the packed card. As for NewJoker, that is
+// spades for the black joker and hearts for the red.
+func (p PackedCard) Suit() Suit {
+	switch p {
+	case blackJoker:
+		return Spade
+	case redJoker:
+		return Heart
+	}
+	return Suit(p / 13)
+}
+
+// Card unpacks the receiver into a new card
+func (p PackedCard) Card() *Card {
+	return NewCard(p.Rank(), p.Suit())
+}
+
+// Mask returns a set containing only the receiver
+func (p PackedCard) Mask() CardMask {
+	return CardMask(1) << p
+}
+
+func (p PackedCard) String() string {
+	return p.Card().String()
+}
+
+// CardMask is a set of distinct cards stored as a 64-bit mask, with the
+// card whose packed encoding is i held in bit i. Unlike a CardSet it is
+// unordered and cannot hold the same card twice.
+type CardMask uint64
+
+// FullDeckMask contains every card in a standard 52 card deck
+const FullDeckMask CardMask = 1<<52 - 1
+
+// Mask returns the set of distinct cards in the card set. Any jokers are
+// held in the bits after FullDeckMask.
+func (c CardSet) Mask() CardMask {
+	var m CardMask
+	for _, card := range c {
+		m |= card.Pack().Mask()
+	}
+	return m
+}
+
+// True if any of the cards is a joker
+func (c CardSet) hasJoker() bool {
+	for _, card := range c {
+		if card.IsJoker() {
+			return true
+		}
+	}
+	return false
+}
+
+// The cards in the set other than jokers, in a new set
+func (c CardSet) withoutJokers() CardSet {
+	naturals := CardSet{}
+	for _, card := range c {
+		if !card.IsJoker() {
+			naturals = append(naturals, card)
+		}
+	}
+	return naturals
+}
+
+// CardSet returns the cards in the mask as a card set, ordered by
+// their packed encoding
+func (m CardMask) CardSet() CardSet {
+	cards := make(CardSet, 0, m.Count())
+	m.ForEach(func(p PackedCard) {
+		cards = append(cards, p.Card())
+	})
+	return cards
+}
+
+// Add returns the receiver with the given card added
+func (m CardMask) Add(p PackedCard) CardMask {
+	return m | p.Mask()
+}
+
+// Contains returns true if the given card is in the receiver
+func (m CardMask) Contains(p PackedCard) bool {
+	return m&p.Mask() != 0
+}
+
+// Union returns the cards in either the receiver or the other mask
+func (m CardMask) Union(other CardMask) CardMask {
+	return m | other
+}
+
+// Intersect returns the cards in both the receiver and the other mask
+func (m CardMask) Intersect(other CardMask) CardMask {
+	return m & other
+}
+
+// Remove returns the receiver without any of the cards in the other mask
+func (m CardMask) Remove(other CardMask) CardMask {
+	return m &^ other
+}
+
+// Count returns the number of cards in the receiver
+func (m CardMask) Count() int {
+	return bits.OnesCount64(uint64(m))
+}
+
+// Lowest returns the card in the receiver with the lowest packed
+// encoding. The receiver must not be empty.
+func (m CardMask) Lowest() PackedCard {
+	return PackedCard(bits.TrailingZeros64(uint64(m)))
+}
+
+// ForEach calls fn with each card in the receiver, in order of their
+// packed encoding
+func (m CardMask) ForEach(fn func(PackedCard)) {
+	for m != 0 {
+		p := m.Lowest()
+		fn(p)
+		m &= m - 1
+	}
+}
+
+// Cards appends the cards in the receiver to dst, in order of their
+// packed encoding, and returns the extended slice. Passing a slice with
+// enough capacity avoids allocating.
+func (m CardMask) Cards(dst []PackedCard) []PackedCard {
+	for m != 0 {
+		dst = append(dst, m.Lowest())
+		m &= m - 1
+	}
+	return dst
+}
+
+func (m CardMask) String() string {
+	s := ""
+	m.ForEach(func(p PackedCard) {
+		s += p.String()
+	})
+	return s
+}
@@ -0,0 +1,78 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Packed cards", func() {
+	It("gives every card in the deck a distinct index below 52", func() {
+		seen := map[PackedCard]bool{}
+		cards, _ := NewDeck().Draw(52)
+		for _, card := range cards {
+			p := card.Pack()
+			Expect(p).To(BeNumerically("<", 52))
+			Expect(seen[p]).To(BeFalse())
+			seen[p] = true
+			Expect(p.Card()).To(Equal(card))
+			Expect(p.Rank()).To(Equal(card.Rank))
+			Expect(p.Suit()).To(Equal(card.Suit))
+		}
+	})
+
+	It("prints like a card", func() {
+		Expect(NewPackedCard(Ace, Spade).String()).To(Equal("A♠"))
+	})
+
+	It("packs jokers outside the deck", func() {
+		black, red := NewJoker(Black).Pack(), NewJoker(Red).Pack()
+		Expect(black.Card()).To(Equal(NewJoker(Black)))
+		Expect(red.Card()).To(Equal(NewJoker(Red)))
+		m := CardSet{NewCard(Two, Heart), NewJoker(Black), NewJoker(Red)}.Mask()
+		Expect(m.Count()).To(Equal(3))
+		Expect(m.Intersect(FullDeckMask)).To(Equal(NewPackedCard(Two, Heart).Mask()))
+	})
+})
+
+var _ = Describe("Card masks", func() {
+	aceOfSpades := NewPackedCard(Ace, Spade)
+	kingOfHearts := NewPackedCard(King, Heart)
+	twoOfClubs := NewPackedCard(Two, Club)
+
+	It("holds every card in a full deck", func() {
+		Expect(FullDeckMask.Count()).To(Equal(52))
+		cards, _ := NewDeck().Draw(52)
+		Expect(cards.Mask()).To(Equal(FullDeckMask))
+	})
+
+	It("converts to and from card sets", func() {
+		cards := CardSet{NewCard(Two, Club), NewCard(Ace, Spade), NewCard(King, Heart)}
+		m := cards.Mask()
+		Expect(m.Count()).To(Equal(3))
+		Expect(m.CardSet()).To(Equal(CardSet{NewCard(Ace, Spade), NewCard(King, Heart), NewCard(Two, Club)}))
+	})
+
+	It("supports set operations", func() {
+		a := CardMask(0).Add(aceOfSpades).Add(kingOfHearts)
+		b := twoOfClubs.Mask().Add(kingOfHearts)
+
+		Expect(a.Contains(aceOfSpades)).To(BeTrue())
+		Expect(a.Contains(twoOfClubs)).To(BeFalse())
+		Expect(a.Union(b).Count()).To(Equal(3))
+		Expect(a.Intersect(b)).To(Equal(kingOfHearts.Mask()))
+		Expect(a.Remove(b)).To(Equal(aceOfSpades.Mask()))
+	})
+
+	It("iterates over its cards in order", func() {
+		m := CardMask(0).Add(twoOfClubs).Add(aceOfSpades).Add(kingOfHearts)
+		visited := []PackedCard{}
+		m.ForEach(func(p PackedCard) {
+			visited = append(visited, p)
+		})
+		Expect(visited).To(Equal([]PackedCard{aceOfSpades, kingOfHearts, twoOfClubs}))
+		Expect(m.Cards(nil)).To(Equal(visited))
+		Expect(m.Lowest()).To(Equal(aceOfSpades))
+	})
+})
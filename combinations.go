@@ -2,14 +2,13 @@ package goker
 
 import "fmt"
 
-func combinations(choose int, cards CardSet) []CardSet {
+func combinations(choose int, cards CardSet) ([]CardSet, error) {
 	if choose > len(cards) {
-		msg := fmt.Sprintf("Can't choose %d from %d cards", choose, len(cards))
-		panic(msg)
+		return nil, fmt.Errorf("%w: can't choose %d from %d cards", ErrNotEnoughCards, choose, len(cards))
 	}
 
 	if choose == 0 {
-		return []CardSet{CardSet{}}
+		return []CardSet{CardSet{}}, nil
 	}
 
 	lastRootIndex := len(cards) - choose
@@ -17,11 +16,12 @@ func combinations(choose int, cards CardSet) []CardSet {
 
 	s := make([]CardSet, 0)
 	for i, root := range roots {
-		c := concat(root, combinations(choose-1, cards[i+1:]))
-		s = append(s, c...)
+		// There are always enough cards after each root to choose the rest
+		rest, _ := combinations(choose-1, cards[i+1:])
+		s = append(s, concat(root, rest)...)
 	}
 
-	return s
+	return s, nil
 }
 
 func concat(root *Card, slice []CardSet) []CardSet {
@@ -1,29 +1,111 @@
 package goker
 
 import (
+	"fmt"
 	"math/rand"
 )
 
 // CardSet is a slice of an arbitrary number of cards
 type CardSet []*Card
 
-// Deck represents a standard 52 card deck
+// Deck represents a deck of cards, usually a standard 52 card deck
 type Deck struct {
-	cards CardSet
+	cards    CardSet
+	full     CardSet
+	shuffler Shuffler
+
+	// The seed of the current deal, and of the one after the next Reset,
+	// if the deck is seeded
+	seed     int64
+	nextSeed int64
+	seeded   bool
 }
 
 // NewDeck constructs a CardSet representing a standard
-// 52 card deck
+// 52 card deck, shuffled with a randomly chosen seed which
+// is recorded so that the deal can be replayed
 func NewDeck() *Deck {
+	return NewSeededDeck(randomSeed())
+}
+
+// NewSeededDeck constructs a standard 52 card deck shuffled by a
+// pseudo-random source with the given seed. Decks with the same seed
+// deal the same cards, provided they are drawn from and reset in the
+// same way.
+func NewSeededDeck(seed int64) *Deck {
+	return newSeededDeck(standardCards(), seed)
+}
+
+// NewDeckWithSource constructs a standard 52 card deck shuffled by
+// the given source of pseudo-random numbers
+func NewDeckWithSource(src rand.Source) *Deck {
+	return NewDeckWithShuffler(rand.New(src))
+}
+
+// NewDeckWithShuffler constructs a standard 52 card deck shuffled
+// by the given shuffler
+func NewDeckWithShuffler(s Shuffler) *Deck {
+	return newDeck(standardCards(), s)
+}
+
+func newDeck(cards CardSet, s Shuffler) *Deck {
+	d := Deck{full: cards, shuffler: s}
+	d.Reset()
+	return &d
+}
+
+func newSeededDeck(cards CardSet, seed int64) *Deck {
+	d := Deck{full: cards, nextSeed: seed, seeded: true}
+	d.Reset()
+	return &d
+}
+
+// NewShortDeck constructs a 36 card deck for short deck (Six-plus)
+// Hold'em, with the Twos through Fives removed, shuffled with a randomly
+// chosen seed which is recorded so that the deal can be replayed
+func NewShortDeck() *Deck {
+	return NewSeededShortDeck(randomSeed())
+}
+
+// NewSeededShortDeck constructs a 36 card short deck shuffled by a
+// pseudo-random source with the given seed
+func NewSeededShortDeck(seed int64) *Deck {
+	return Shoe{WithoutRanks: []Rank{Two, Three, Four, Five}}.NewSeededDeck(seed)
+}
+
+// NewDeckWithJokers constructs a standard 52 card deck with the given
+// number of jokers added, alternately black and red, shuffled with a
+// randomly chosen seed which is recorded so that the deal can be replayed
+func NewDeckWithJokers(jokers int) *Deck {
+	return NewSeededDeckWithJokers(randomSeed(), jokers)
+}
+
+// NewSeededDeckWithJokers constructs a standard 52 card deck with the
+// given number of jokers added, shuffled by a pseudo-random source with
+// the given seed
+func NewSeededDeckWithJokers(seed int64, jokers int) *Deck {
+	return Shoe{Jokers: jokers}.NewSeededDeck(seed)
+}
+
+func standardCards() CardSet {
 	cards := CardSet{}
-	for s := Spade; s <= Club; s++ {
-		for r := Two; r <= Ace; r++ {
+	for _, s := range AllSuits() {
+		for _, r := range AllRanks() {
 			cards = append(cards, NewCard(r, s))
 		}
 	}
-	d := Deck{cards}
-	d.Shuffle()
-	return &d
+	return cards
+}
+
+// Seed returns the seed the current deal was shuffled with, so that it
+// can be replayed with NewSeededDeck, or the seeded constructor for the
+// same kind of deck, e.g. NewSeededShortDeck or Shoe.NewSeededDeck. Each
+// Reset shuffles with a new seed, drawn from the last one, so a deal
+// after a reset is replayed from its own seed alone. The second return
+// value is false if the deck was constructed with a source or shuffler
+// of its own.
+func (d Deck) Seed() (int64, bool) {
+	return d.seed, d.seeded
 }
 
 // Len returns the number of cards remaining in the deck
@@ -34,15 +116,100 @@ func (d Deck) Len() int {
 // Shuffle reorders the cards in the deck randomly, in place
 func (d *Deck) Shuffle() {
 	for i := len(d.cards) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := d.shuffler.Intn(i + 1)
 		d.cards[i], d.cards[j] = d.cards[j], d.cards[i]
 	}
 }
 
-// Draw removes and returns the top n cards from the deck
-func (d *Deck) Draw(n int) CardSet {
-	splitIndex := d.Len() - n
-	cards := d.cards[splitIndex:]
-	d.cards = d.cards[0:splitIndex]
-	return cards
+// Reset returns every card that has been drawn, burned or removed to
+// the deck and shuffles it. A seeded deck is reseeded first, so that
+// Seed describes the new deal.
+func (d *Deck) Reset() {
+	d.cards = make(CardSet, len(d.full))
+	for i, card := range d.full {
+		c := *card
+		d.cards[i] = &c
+	}
+	if !d.seeded {
+		d.Shuffle()
+		return
+	}
+
+	rng := rand.New(rand.NewSource(d.nextSeed))
+	d.seed, d.shuffler = d.nextSeed, rng
+	d.Shuffle()
+	d.nextSeed = rng.Int63()
+}
+
+// Draw removes and returns the top n cards from the deck, in the order
+// they were dealt. If fewer than n cards remain, it returns
+// ErrNotEnoughCards and leaves the deck untouched.
+func (d *Deck) Draw(n int) (CardSet, error) {
+	cards, err := d.Peek(n)
+	if err != nil {
+		return nil, err
+	}
+	d.cards = d.cards[:d.Len()-n]
+	return cards, nil
+}
+
+// Burn discards the top card of the deck, returning it so that it can be
+// recorded. If the deck is empty, it returns ErrNotEnoughCards.
+func (d *Deck) Burn() (*Card, error) {
+	cards, err := d.Draw(1)
+	if err != nil {
+		return nil, err
+	}
+	return cards[0], nil
+}
+
+// Peek returns the top n cards of the deck, in the order they would be
+// dealt, without removing them. If fewer than n cards remain, it returns
+// ErrNotEnoughCards.
+func (d Deck) Peek(n int) (CardSet, error) {
+	if n < 0 || n > d.Len() {
+		return nil, fmt.Errorf("%w: can't draw %d from a deck of %d", ErrNotEnoughCards, n, d.Len())
+	}
+
+	// The top of the deck is the end of the slice
+	cards := make(CardSet, n)
+	for i := range cards {
+		cards[i] = d.cards[d.Len()-1-i]
+	}
+	return cards, nil
+}
+
+// Contains returns true if a card of the same rank and suit as the one
+// provided remains in the deck
+func (d Deck) Contains(card *Card) bool {
+	return d.indexOf(*card) >= 0
+}
+
+// Remove takes the given cards out of the deck, e.g. because they are
+// known to be dead. If any of them is not in the deck, it returns
+// ErrCardNotInDeck and leaves the deck untouched.
+func (d *Deck) Remove(cards ...*Card) error {
+	remaining := append(CardSet{}, d.cards...)
+	for _, card := range cards {
+		i := remaining.indexOf(*card)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrCardNotInDeck, card)
+		}
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+	d.cards = remaining
+	return nil
+}
+
+func (d Deck) indexOf(card Card) int {
+	return d.cards.indexOf(card)
+}
+
+func (c CardSet) indexOf(card Card) int {
+	for i := range c {
+		if *c[i] == card {
+			return i
+		}
+	}
+	return -1
 }
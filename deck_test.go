@@ -1,6 +1,8 @@
 package goker_test
 
 import (
+	"errors"
+
 	. "github.com/sozorogami/goker"
 
 	. "github.com/onsi/ginkgo"
@@ -14,12 +16,88 @@ var _ = Describe("A deck of cards", func() {
 	})
 	Context("when you draw cards from it", func() {
 		deck := NewDeck()
-		cards := deck.Draw(5)
+		top, _ := deck.Peek(5)
+		cards, err := deck.Draw(5)
 		It("decreases in size", func() {
 			Expect(deck.Len()).To(Equal(52 - 5))
 		})
 		It("gives you cards", func() {
+			Expect(err).NotTo(HaveOccurred())
 			Expect(len(cards)).To(Equal(5))
 		})
+		It("gives you the cards from the top of the deck", func() {
+			Expect(cards).To(Equal(top))
+		})
+		It("no longer contains them", func() {
+			for _, card := range cards {
+				Expect(deck.Contains(card)).To(BeFalse())
+			}
+		})
+	})
+	Context("when you draw more cards than it holds", func() {
+		deck := NewDeck()
+		deck.Draw(50)
+		_, err := deck.Draw(3)
+		It("returns an error", func() {
+			Expect(errors.Is(err, ErrNotEnoughCards)).To(BeTrue())
+		})
+		It("leaves the deck as it was", func() {
+			Expect(deck.Len()).To(Equal(2))
+		})
+	})
+	It("deals the same cards whether drawn at once or one at a time", func() {
+		deck := NewDeck()
+		all, _ := deck.Peek(4)
+		first, _ := deck.Draw(2)
+		second, _ := deck.Draw(2)
+		Expect(append(first, second...)).To(Equal(all))
+	})
+	It("doesn't share cards with what it dealt", func() {
+		deck := NewDeck()
+		cards, _ := deck.Draw(2)
+		cards[0], cards[1] = nil, nil
+		rest, _ := deck.Draw(50)
+		Expect(rest).NotTo(ContainElement(BeNil()))
+	})
+	It("burns the top card", func() {
+		deck := NewDeck()
+		top, _ := deck.Peek(1)
+		burned, err := deck.Burn()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(burned).To(Equal(top[0]))
+		Expect(deck.Len()).To(Equal(51))
+	})
+	It("can't burn from an empty deck", func() {
+		deck := NewDeck()
+		deck.Draw(52)
+		_, err := deck.Burn()
+		Expect(errors.Is(err, ErrNotEnoughCards)).To(BeTrue())
+	})
+	Context("when removing dead cards", func() {
+		It("takes them out of the deck", func() {
+			deck := NewDeck()
+			Expect(deck.Remove(NewCard(Ace, Spade), NewCard(King, Heart))).To(Succeed())
+			Expect(deck.Len()).To(Equal(50))
+			Expect(deck.Contains(NewCard(Ace, Spade))).To(BeFalse())
+			Expect(deck.Contains(NewCard(Queen, Heart))).To(BeTrue())
+		})
+		It("refuses to remove cards that aren't there", func() {
+			deck := NewDeck()
+			deck.Remove(NewCard(Ace, Spade))
+			err := deck.Remove(NewCard(King, Heart), NewCard(Ace, Spade))
+			Expect(errors.Is(err, ErrCardNotInDeck)).To(BeTrue())
+			Expect(deck.Len()).To(Equal(51))
+			Expect(deck.Contains(NewCard(King, Heart))).To(BeTrue())
+		})
+	})
+	It("can be reset to a full deck", func() {
+		deck := NewDeck()
+		deck.Draw(10)
+		deck.Burn()
+		deck.Remove(NewCard(Two, Club))
+		deck.Reset()
+		Expect(deck.Len()).To(Equal(52))
+		cards, _ := deck.Peek(52)
+		Expect(cards.Mask()).To(Equal(FullDeckMask))
 	})
 })
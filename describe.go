@@ -0,0 +1,177 @@
+package goker
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Locale holds the words and templates used to describe hands in a
+// particular language
+type Locale struct {
+	// Ranks holds the names of each rank
+	Ranks map[Rank]RankNames
+
+	// Templates holds a text/template for each kind of hand, keyed by the
+	// Name() of its rank. Templates are executed with a HandDescription,
+	// and may call the functions one, many and a with a rank to get its
+	// RankNames. The template keyed "Wilds" is appended to the
+	// description of hands with wild cards.
+	Templates map[string]string
+}
+
+// RankNames are the ways a rank is written in a locale
+type RankNames struct {
+	// One is the name of a single card of the rank, e.g. "Six"
+	One string
+	// Many is the name of several cards of the rank, e.g. "Sixes"
+	Many string
+	// A is the name of a single card with an indefinite article, e.g.
+	// "a Six"
+	A string
+}
+
+// HandDescription holds the details of a hand that describe it, for use
+// in a Locale's templates
+type HandDescription struct {
+	// Primary is the rank that matters most: the rank of a pair, trips or
+	// quads, the higher pair of two pair, or the high card otherwise
+	Primary Rank
+	// Secondary is the pair in a full house, or the lower pair of two pair
+	Secondary Rank
+	// Kicker is the highest of Kickers, if any
+	Kicker Rank
+	// Kickers are the ranks of the cards which don't make up the hand,
+	// highest first
+	Kickers []Rank
+	// Wilds are the hand's wild cards and what they played as, highest
+	// first
+	Wilds []WildCard
+}
+
+// English describes hands in English, e.g. "Full House, Kings full of
+// Sevens" or "Straight, Five high". Describe parses its templates once,
+// when the package is initialized, so changes to it only apply to
+// DescribeIn.
+var English = Locale{
+	Ranks: map[Rank]RankNames{
+		Two:   {"Two", "Twos", "a Two"},
+		Three: {"Three", "Threes", "a Three"},
+		Four:  {"Four", "Fours", "a Four"},
+		Five:  {"Five", "Fives", "a Five"},
+		Six:   {"Six", "Sixes", "a Six"},
+		Seven: {"Seven", "Sevens", "a Seven"},
+		Eight: {"Eight", "Eights", "an Eight"},
+		Nine:  {"Nine", "Nines", "a Nine"},
+		Ten:   {"Ten", "Tens", "a Ten"},
+		Jack:  {"Jack", "Jacks", "a Jack"},
+		Queen: {"Queen", "Queens", "a Queen"},
+		King:  {"King", "Kings", "a King"},
+		Ace:   {"Ace", "Aces", "an Ace"},
+	},
+	Templates: map[string]string{
+		"FiveOfAKind":        "Five of a Kind, {{many .Primary}}",
+		"RoyalStraightFlush": "Royal Flush",
+		"StraightFlush":      "Straight Flush, {{one .Primary}} high",
+		"FourOfAKind":        "Four of a Kind, {{many .Primary}} with {{a .Kicker}} kicker",
+		"FullHouse":          "Full House, {{many .Primary}} full of {{many .Secondary}}",
+		"Flush":              "Flush, {{one .Primary}} high",
+		"Straight":           "Straight, {{one .Primary}} high",
+		"ThreeOfAKind":       "Three of a Kind, {{many .Primary}}",
+		"TwoPair":            "Two Pair, {{many .Primary}} and {{many .Secondary}} with {{a .Kicker}} kicker",
+		"Pair":               "Pair of {{many .Primary}}",
+		"HighCard":           "High Card, {{one .Primary}}",
+		"AceToFiveLow":       "{{one .Primary}}-{{one .Kicker}} Low",
+		"DeuceToSevenLow":    "{{one .Primary}}-{{one .Kicker}} Low",
+		"Unrankable":         "Unrankable without Wild Cards",
+		"Wilds":              " ({{range $i, $w := .Wilds}}{{if $i}}, {{end}}{{$w.Card}} as {{a $w.As.Rank}}{{end}})",
+	},
+}
+
+// The templates of English, parsed once
+var englishTemplates = template.Must(English.parse())
+
+// Describe returns a description of the hand in English, e.g.
+// "Two Pair, Aces and Fours with a Jack kicker"
+func (h Hand) Describe() string {
+	s, err := h.describeWith(englishTemplates)
+	if err != nil {
+		return h.Rank().Name()
+	}
+	return s
+}
+
+// DescribeIn returns a description of the hand using the words and
+// templates of the given locale. It returns an error if any of the
+// locale's templates fails to parse, or if its template for the hand is
+// missing or fails to execute.
+func (h Hand) DescribeIn(l Locale) (string, error) {
+	t, err := l.parse()
+	if err != nil {
+		return "", err
+	}
+	return h.describeWith(t)
+}
+
+func (h Hand) describeWith(t *template.Template) (string, error) {
+	rank := h.Rank()
+	d := describe(rank)
+
+	var b strings.Builder
+	if err := execute(t, &b, rank.Name(), d); err != nil {
+		return "", err
+	}
+	if len(d.Wilds) > 0 {
+		if err := execute(t, &b, "Wilds", d); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// Parses every one of the locale's templates into a single set, each
+// named by its key
+func (l Locale) parse() (*template.Template, error) {
+	funcs := template.FuncMap{
+		"one":  func(r Rank) string { return l.Ranks[r].One },
+		"many": func(r Rank) string { return l.Ranks[r].Many },
+		"a":    func(r Rank) string { return l.Ranks[r].A },
+	}
+
+	t := template.New("").Funcs(funcs)
+	for name, text := range l.Templates {
+		if _, err := t.New(name).Parse(text); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Executes the template with the given name from the set, writing the
+// result to the builder
+func execute(t *template.Template, b *strings.Builder, name string, d HandDescription) error {
+	named := t.Lookup(name)
+	if named == nil {
+		return fmt.Errorf("locale has no template for %s", name)
+	}
+	return named.Execute(b, d)
+}
+
+func describe(hr HandRank) HandDescription {
+	d := HandDescription{Kickers: hr.Kickers()}
+
+	primary := hr.Primary()
+	if len(primary) > 0 {
+		d.Primary = primary[0]
+	}
+	if len(primary) > 1 {
+		d.Secondary = primary[1]
+	}
+	if len(d.Kickers) > 0 {
+		d.Kicker = d.Kickers[0]
+	}
+	if w, ok := hr.(*wildRank); ok {
+		d.Wilds = w.wilds
+	}
+	return d
+}
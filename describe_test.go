@@ -0,0 +1,75 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func mustParseHand(s string) *Hand {
+	h, err := ParseHand(s)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+var _ = Describe("Describing hands", func() {
+	It("describes every kind of hand in English", func() {
+		Expect(royalStraightFlush.Describe()).To(Equal("Royal Flush"))
+		Expect(straightFlush.Describe()).To(Equal("Straight Flush, King high"))
+		Expect(fourOfAKind.Describe()).To(Equal("Four of a Kind, Tens with a Nine kicker"))
+		Expect(fullHouse.Describe()).To(Equal("Full House, Tens full of Nines"))
+		Expect(flush.Describe()).To(Equal("Flush, King high"))
+		Expect(straight.Describe()).To(Equal("Straight, Six high"))
+		Expect(threeOfAKind.Describe()).To(Equal("Three of a Kind, Fours"))
+		Expect(twoPair.Describe()).To(Equal("Two Pair, Sevens and Fours with a Nine kicker"))
+		Expect(pair.Describe()).To(Equal("Pair of Fours"))
+		Expect(highCard.Describe()).To(Equal("High Card, Nine"))
+	})
+
+	It("orders the pairs in two pair", func() {
+		Expect(mustParseHand("4s4dAcAhJs").Describe()).To(Equal("Two Pair, Aces and Fours with a Jack kicker"))
+		Expect(mustParseHand("KsKdAcAh8s").Describe()).To(Equal("Two Pair, Aces and Kings with an Eight kicker"))
+	})
+
+	It("plays the ace low in a five high straight", func() {
+		Expect(aceLowStraight.Describe()).To(Equal("Straight, Five high"))
+		Expect(mustParseHand("Ah2h3h4h5h").Describe()).To(Equal("Straight Flush, Five high"))
+	})
+
+	It("describes full houses by trips first", func() {
+		Expect(mustParseHand("KsKdKc7h7s").Describe()).To(Equal("Full House, Kings full of Sevens"))
+		Expect(mustParseHand("7s7d7cKhKs").Describe()).To(Equal("Full House, Sevens full of Kings"))
+	})
+
+	Describe("in another locale", func() {
+		pirate := Locale{
+			Ranks: English.Ranks,
+			Templates: map[string]string{
+				"Pair": "a brace o' {{many .Primary}}, {{one .Kicker}} in the hold",
+			},
+		}
+
+		It("uses the locale's templates", func() {
+			Expect(pair.DescribeIn(pirate)).To(Equal("a brace o' Fours, Nine in the hold"))
+		})
+
+		It("has access to every detail of the hand", func() {
+			l := Locale{Ranks: English.Ranks, Templates: map[string]string{
+				"ThreeOfAKind": "{{many .Primary}}{{range .Kickers}}, {{one .}}{{end}}",
+			}}
+			Expect(threeOfAKind.DescribeIn(l)).To(Equal("Fours, Nine, Seven"))
+		})
+
+		It("fails for a hand it can't describe", func() {
+			_, err := flush.DescribeIn(Locale{Templates: map[string]string{"Flush": "{{.Nonsense}}"}})
+			Expect(err).To(HaveOccurred())
+			_, err = straight.DescribeIn(Locale{Templates: map[string]string{"Flush": "Flush"}})
+			Expect(err).To(HaveOccurred())
+			_, err = straight.DescribeIn(Locale{Templates: map[string]string{"Straight": "{{one .Primary"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
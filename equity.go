@@ -0,0 +1,296 @@
+package goker
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// EquityResult reports how one player's hand fares against the others
+// over every board dealt in an equity calculation. Each figure is a
+// fraction of the boards, from 0 to 1.
+type EquityResult struct {
+	// Win is how often the player had the best hand outright
+	Win float64
+	// Tie is how often the player split the best hand with others
+	Tie float64
+	// Loss is how often another player had a better hand
+	Loss float64
+	// Equity is the player's expected share of the pot, counting a tie
+	// between n players as 1/n of a win
+	Equity float64
+}
+
+// EquityOptions configures an equity calculation
+type EquityOptions struct {
+	// Iterations is the number of random boards to deal. It must be
+	// positive even if every runout is enumerated instead.
+	Iterations int
+	// Workers is the number of goroutines to deal boards on. If it is
+	// zero, one is used per available CPU.
+	Workers int
+	// Seed seeds the random boards. Calculations with the same cards and
+	// options, including the number of workers, give the same results.
+	Seed int64
+	// ExactThreshold is the largest number of possible board runouts for
+	// which every runout is enumerated, giving exact results, instead of
+	// dealing random boards. If it is zero, DefaultExactThreshold is used,
+	// and if it is negative, boards are always dealt at random.
+	ExactThreshold int
+}
+
+// DefaultExactThreshold is the number of possible runouts below which
+// equity is calculated exactly unless configured otherwise. It covers any
+// number of players once the flop is out, but not preflop.
+const DefaultExactThreshold = 50000
+
+// Equity estimates how often each player's two hole cards win, tie or lose
+// by dealing random completions of the board from the deck, less the hole
+// cards, the board and any dead cards. The best five card hand for each
+// player is found from their hole cards and the board, and ties split the
+// pot as in WinnerTiers. Results are in the same order as holeCards.
+// When there are few enough possible runouts, every one of them is
+// enumerated instead, as in ExactEquity.
+func Equity(holeCards []CardSet, board CardSet, dead CardSet, iterations int) ([]EquityResult, error) {
+	return EquityWithOptions(holeCards, board, dead, EquityOptions{
+		Iterations: iterations,
+		Seed:       randomSeed(),
+	})
+}
+
+// EquityWithOptions is like Equity, with control over the number of
+// goroutines used and the random seed
+func EquityWithOptions(holeCards []CardSet, board CardSet, dead CardSet, opts EquityOptions) ([]EquityResult, error) {
+	hole, boardMask, remaining, err := equityCards(holeCards, board, dead)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Iterations <= 0 {
+		return nil, ErrNoIterations
+	}
+
+	threshold := opts.ExactThreshold
+	if threshold == 0 {
+		threshold = DefaultExactThreshold
+	}
+	if runouts := binomial(len(remaining), 5-len(board)); runouts <= threshold {
+		return dealEveryBoard(hole, boardMask, remaining).results(), nil
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > opts.Iterations {
+		workers = opts.Iterations
+	}
+
+	tallies := make([]*equityTally, workers)
+	var wg sync.WaitGroup
+	for w := range tallies {
+		iterations := opts.Iterations / workers
+		if w < opts.Iterations%workers {
+			iterations++
+		}
+
+		wg.Add(1)
+		go func(w, iterations int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(opts.Seed + int64(w)))
+			tallies[w] = dealRandomBoards(hole, boardMask, remaining, iterations, rng)
+		}(w, iterations)
+	}
+	wg.Wait()
+
+	total := newEquityTally(len(hole))
+	for _, t := range tallies {
+		total.add(t)
+	}
+	return total.results(), nil
+}
+
+// ExactEquity calculates exactly how often each player's two hole cards
+// win, tie or lose, by dealing every possible completion of the board from
+// the deck, less the hole cards, the board and any dead cards. It is only
+// practical once a few board cards are known; see Equity.
+func ExactEquity(holeCards []CardSet, board CardSet, dead CardSet) ([]EquityResult, error) {
+	hole, boardMask, remaining, err := equityCards(holeCards, board, dead)
+	if err != nil {
+		return nil, err
+	}
+	return dealEveryBoard(hole, boardMask, remaining).results(), nil
+}
+
+// Validates the cards for an equity calculation, returning them as masks
+// along with the cards that remain to be dealt
+func equityCards(holeCards []CardSet, board CardSet, dead CardSet) ([]CardMask, CardMask, []PackedCard, error) {
+	if len(holeCards) < 2 {
+		return nil, 0, nil, ErrNotEnoughPlayers
+	}
+	if len(board) > 5 {
+		return nil, 0, nil, fmt.Errorf("%w: a board has at most 5 cards, found %d", ErrInvalidBoard, len(board))
+	}
+
+	known := CardSet{}
+	hole := make([]CardMask, len(holeCards))
+	for i, cards := range holeCards {
+		if len(cards) != 2 {
+			return nil, 0, nil, fmt.Errorf("%w: player %d has %d", ErrInvalidHoleCards, i+1, len(cards))
+		}
+		if cards.hasJoker() {
+			return nil, 0, nil, fmt.Errorf("%w: player %d has a joker", ErrInvalidHoleCards, i+1)
+		}
+		hole[i] = cards.Mask()
+		known = append(known, cards...)
+	}
+	if board.hasJoker() || dead.hasJoker() {
+		return nil, 0, nil, fmt.Errorf("%w: jokers can't be dealt in hold'em", ErrInvalidBoard)
+	}
+	known = append(known, board...)
+	known = append(known, dead...)
+
+	knownMask := known.Mask()
+	if knownMask.Count() != len(known) {
+		return nil, 0, nil, fmt.Errorf("%w among hole cards, board and dead cards", ErrDuplicateCard)
+	}
+
+	remaining := FullDeckMask.Remove(knownMask).Cards(nil)
+	if len(remaining) < 5-len(board) {
+		return nil, 0, nil, fmt.Errorf("%w: %d left to complete the board", ErrNotEnoughCards, len(remaining))
+	}
+	return hole, board.Mask(), remaining, nil
+}
+
+// Deals random completions of the board from the remaining cards, drawing
+// each one with a partial Fisher-Yates shuffle
+func dealRandomBoards(hole []CardMask, board CardMask, remaining []PackedCard, iterations int, rng *rand.Rand) *equityTally {
+	deck := append([]PackedCard(nil), remaining...)
+	need := 5 - board.Count()
+	tally := newEquityTally(len(hole))
+	strengths := make([]Strength, len(hole))
+
+	for i := 0; i < iterations; i++ {
+		runout := board
+		for j := 0; j < need; j++ {
+			k := j + rng.Intn(len(deck)-j)
+			deck[j], deck[k] = deck[k], deck[j]
+			runout = runout.Add(deck[j])
+		}
+
+		for p := range hole {
+			strengths[p] = hole[p].Union(runout).Strength()
+		}
+		tally.record(strengths)
+	}
+
+	return tally
+}
+
+// Deals every combination of the remaining cards that completes the board
+func dealEveryBoard(hole []CardMask, board CardMask, remaining []PackedCard) *equityTally {
+	tally := newEquityTally(len(hole))
+	strengths := make([]Strength, len(hole))
+	forEachRunout(board, remaining, 5-board.Count(), func(full CardMask) {
+		for p := range hole {
+			strengths[p] = hole[p].Union(full).Strength()
+		}
+		tally.record(strengths)
+	})
+	return tally
+}
+
+// Calls fn with the board completed by each combination of n of the
+// remaining cards in turn, without building them all up front
+func forEachRunout(board CardMask, remaining []PackedCard, n int, fn func(CardMask)) {
+	if n == 0 {
+		fn(board)
+		return
+	}
+	for i := 0; i <= len(remaining)-n; i++ {
+		forEachRunout(board.Add(remaining[i]), remaining[i+1:], n-1, fn)
+	}
+}
+
+// The number of ways to choose k things from n, capped so as not to
+// overflow for sizes beyond any threshold
+func binomial(n, k int) int {
+	const limit = 1 << 40
+	result := 1
+	for i := 0; i < k; i++ {
+		result = result * (n - i) / (i + 1)
+		if result > limit {
+			return limit
+		}
+	}
+	return result
+}
+
+// Running totals of the results of each board dealt
+type equityTally struct {
+	wins, ties []int
+	shares     []float64
+	boards     int
+}
+
+func newEquityTally(players int) *equityTally {
+	return &equityTally{
+		wins:   make([]int, players),
+		ties:   make([]int, players),
+		shares: make([]float64, players),
+	}
+}
+
+// Records the result of a board given the strength of each player's hand
+func (t *equityTally) record(strengths []Strength) {
+	best, winners := bestStrength(strengths)
+	for p, s := range strengths {
+		if s != best {
+			continue
+		}
+		if winners == 1 {
+			t.wins[p]++
+		} else {
+			t.ties[p]++
+		}
+		t.shares[p] += 1 / float64(winners)
+	}
+	t.boards++
+}
+
+// Returns the strongest of the strengths and how many players share it
+func bestStrength(strengths []Strength) (Strength, int) {
+	best, winners := strengths[0], 0
+	for _, s := range strengths {
+		if s > best {
+			best, winners = s, 0
+		}
+		if s == best {
+			winners++
+		}
+	}
+	return best, winners
+}
+
+func (t *equityTally) add(other *equityTally) {
+	for p := range t.wins {
+		t.wins[p] += other.wins[p]
+		t.ties[p] += other.ties[p]
+		t.shares[p] += other.shares[p]
+	}
+	t.boards += other.boards
+}
+
+func (t *equityTally) results() []EquityResult {
+	results := make([]EquityResult, len(t.wins))
+	boards := float64(t.boards)
+	for p := range results {
+		results[p] = EquityResult{
+			Win:    float64(t.wins[p]) / boards,
+			Tie:    float64(t.ties[p]) / boards,
+			Loss:   float64(t.boards-t.wins[p]-t.ties[p]) / boards,
+			Equity: t.shares[p] / boards,
+		}
+	}
+	return results
+}
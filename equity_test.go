@@ -0,0 +1,136 @@
+package goker_test
+
+import (
+	"errors"
+
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func mustParse(s string) CardSet {
+	cards, err := ParseCardSet(s)
+	if err != nil {
+		panic(err)
+	}
+	return cards
+}
+
+var _ = Describe("Calculating equity", func() {
+	aces := mustParse("AsAh")
+	kings := mustParse("KsKh")
+
+	Context("with random boards", func() {
+		opts := EquityOptions{Iterations: 20000, Workers: 4, Seed: 1}
+
+		It("finds aces are a big favourite over kings", func() {
+			results, err := EquityWithOptions([]CardSet{aces, kings}, nil, nil, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results[0].Equity).To(BeNumerically("~", 0.82, 0.015))
+			Expect(results[1].Equity).To(BeNumerically("~", 0.18, 0.015))
+		})
+
+		It("accounts for every board", func() {
+			results, _ := EquityWithOptions([]CardSet{aces, kings, mustParse("7c2d")}, nil, nil, opts)
+			equity := 0.0
+			for _, r := range results {
+				Expect(r.Win + r.Tie + r.Loss).To(BeNumerically("~", 1, 1e-9))
+				equity += r.Equity
+			}
+			Expect(equity).To(BeNumerically("~", 1, 1e-9))
+		})
+
+		It("gives the same results for the same seed", func() {
+			first, _ := EquityWithOptions([]CardSet{aces, kings}, nil, nil, opts)
+			second, _ := EquityWithOptions([]CardSet{aces, kings}, nil, nil, opts)
+			Expect(first).To(Equal(second))
+		})
+
+		It("never deals dead cards", func() {
+			// Only the two remaining kings can save the pair of queens
+			board := mustParse("QdQc2s3h")
+			results, _ := EquityWithOptions([]CardSet{kings, aces}, board, mustParse("KdKc"), opts)
+			Expect(results[0].Equity).To(BeZero())
+		})
+
+		It("splits the pot when the board plays", func() {
+			results, _ := Equity([]CardSet{mustParse("2c3d"), mustParse("2d3c")}, mustParse("AsKsQsJsTs"), nil, 10)
+			Expect(results[0]).To(Equal(EquityResult{Tie: 1, Equity: 0.5}))
+			Expect(results[1]).To(Equal(EquityResult{Tie: 1, Equity: 0.5}))
+		})
+	})
+
+	Context("by enumerating every board", func() {
+		turn := mustParse("2c7d9hQc")
+
+		It("gives exact results", func() {
+			// Kings need one of the two remaining kings from 44 cards
+			results, err := ExactEquity([]CardSet{aces, kings}, turn, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results[1].Win).To(Equal(2.0 / 44))
+			Expect(results[0].Equity).To(Equal(42.0 / 44))
+		})
+
+		It("is chosen automatically when there are few runouts", func() {
+			results, err := EquityWithOptions([]CardSet{aces, kings}, turn, nil, EquityOptions{Iterations: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results[1].Win).To(Equal(2.0 / 44))
+		})
+
+		It("agrees with random boards", func() {
+			hands := []CardSet{mustParse("AhKh"), mustParse("QsQd"), mustParse("Tc9c")}
+			flop := mustParse("Kd8h2c")
+
+			exact, err := ExactEquity(hands, flop, nil)
+			Expect(err).NotTo(HaveOccurred())
+			random, err := EquityWithOptions(hands, flop, nil, EquityOptions{
+				Iterations:     20000,
+				Seed:           1,
+				ExactThreshold: -1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			for p := range hands {
+				Expect(random[p].Win).To(BeNumerically("~", exact[p].Win, 0.015))
+				Expect(random[p].Tie).To(BeNumerically("~", exact[p].Tie, 0.015))
+				Expect(random[p].Equity).To(BeNumerically("~", exact[p].Equity, 0.015))
+			}
+		})
+	})
+
+	Context("with invalid cards", func() {
+		It("needs at least two players", func() {
+			_, err := Equity([]CardSet{aces}, nil, nil, 100)
+			Expect(err).To(MatchError(ErrNotEnoughPlayers))
+		})
+		It("needs two hole cards per player", func() {
+			_, err := Equity([]CardSet{aces, mustParse("Kd")}, nil, nil, 100)
+			Expect(errors.Is(err, ErrInvalidHoleCards)).To(BeTrue())
+		})
+		It("needs a board of at most five cards", func() {
+			_, err := Equity([]CardSet{aces, kings}, mustParse("2c3c4c5c6c7c"), nil, 100)
+			Expect(errors.Is(err, ErrInvalidBoard)).To(BeTrue())
+		})
+		It("won't deal jokers", func() {
+			_, err := ExactEquity([]CardSet{aces, kings}, nil, CardSet{NewJoker(Red)})
+			Expect(errors.Is(err, ErrInvalidBoard)).To(BeTrue())
+			_, err = ExactEquity([]CardSet{aces, kings}, CardSet{NewJoker(Black)}, nil)
+			Expect(errors.Is(err, ErrInvalidBoard)).To(BeTrue())
+			_, err = ExactEquity([]CardSet{aces, {NewJoker(Red), NewCard(King, Club)}}, nil, nil)
+			Expect(errors.Is(err, ErrInvalidHoleCards)).To(BeTrue())
+		})
+		It("won't deal the same card twice", func() {
+			_, err := Equity([]CardSet{aces, mustParse("AsKd")}, nil, nil, 100)
+			Expect(errors.Is(err, ErrDuplicateCard)).To(BeTrue())
+		})
+		It("needs some iterations", func() {
+			_, err := Equity([]CardSet{aces, kings}, nil, nil, 0)
+			Expect(err).To(MatchError(ErrNoIterations))
+		})
+		It("needs some iterations even when every runout is dealt", func() {
+			_, err := Equity([]CardSet{aces, kings}, mustParse("2c7d9hQc"), nil, 0)
+			Expect(err).To(MatchError(ErrNoIterations))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package goker
+
+import "errors"
+
+var (
+	// ErrNotEnoughPlayers is returned when a showdown has fewer than two
+	// participants, so the winner is already decided
+	ErrNotEnoughPlayers = errors.New("there must be at least two participants")
+
+	// ErrPlayerWithoutHand is returned when a player involved in a
+	// showdown has not been assigned a hand
+	ErrPlayerWithoutHand = errors.New("all players involved in a showdown must have a hand")
+
+	// ErrUnclaimedPot is returned when none of the players in a showdown
+	// are entitled to win one of the pots, so it could not be paid out
+	ErrUnclaimedPot = errors.New("all pots should be paid out at end of showdown")
+
+	// ErrNotEnoughCards is returned when an operation needs more cards
+	// than it was given
+	ErrNotEnoughCards = errors.New("not enough cards")
+
+	// ErrDuplicateCard is returned when the same card appears more than
+	// once where every card must be distinct
+	ErrDuplicateCard = errors.New("duplicate card")
+
+	// ErrInvalidHoleCards is returned when a player holds the wrong number
+	// of hole cards for the game
+	ErrInvalidHoleCards = errors.New("wrong number of hole cards")
+
+	// ErrNeedsHoleCards is returned when finding the best hand from a
+	// single set of cards for a game, such as Omaha, whose hands depend
+	// on which cards are hole cards and which are on the board
+	ErrNeedsHoleCards = errors.New("game needs hole cards and board kept apart")
+
+	// ErrInvalidBoard is returned when a board holds too many cards, or too
+	// few for the game
+	ErrInvalidBoard = errors.New("invalid board")
+
+	// ErrUnexpectedJoker is returned when a joker is played in a game
+	// that isn't ranked by wild card rules
+	ErrUnexpectedJoker = errors.New("jokers can only be played with wild card rules")
+
+	// ErrNoIterations is returned when a simulation is asked to run
+	// without a positive number of iterations
+	ErrNoIterations = errors.New("equity needs a positive number of iterations")
+
+	// ErrNoValidCombos is returned when hand ranges have no combos that
+	// can be dealt together with the board
+	ErrNoValidCombos = errors.New("ranges have no combos that can be dealt together")
+
+	// ErrCardNotInDeck is returned when removing a card that is not in
+	// the deck
+	ErrCardNotInDeck = errors.New("card not in deck")
+
+	// ErrCommitmentMismatch is returned when a revealed server seed does
+	// not match the commitment published before the deal
+	ErrCommitmentMismatch = errors.New("server seed does not match commitment")
+
+	// ErrDealMismatch is returned when the cards dealt are not the ones
+	// the seeds of a provably fair deal produce
+	ErrDealMismatch = errors.New("cards dealt do not match seeds")
+)
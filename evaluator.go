@@ -0,0 +1,57 @@
+package goker
+
+import "sort"
+
+// Evaluator ranks poker hands by the rules of a particular game, so that
+// variants can share the same hand, showdown and pot logic
+type Evaluator interface {
+	// Rank returns the value of the five card hand by the evaluator's
+	// rules. Ranks returned by the same evaluator can be compared.
+	Rank(h Hand) HandRank
+
+	// BestHand returns the best five card hand a player can make from
+	// their hole cards and the board, ranked by the evaluator
+	BestHand(hole, board CardSet) (*Hand, error)
+}
+
+// High ranks hands by the usual rules of poker, with the best five of
+// any of the cards available
+var High Evaluator = highEvaluator{}
+
+type highEvaluator struct{}
+
+func (highEvaluator) Rank(h Hand) HandRank {
+	return h.highRank()
+}
+
+func (highEvaluator) BestHand(hole, board CardSet) (*Hand, error) {
+	return hole.union(board).bestHand(nil)
+}
+
+// SortWith sorts the hands in the group from worst to best as ranked by
+// the given evaluator, regardless of the rules they were made with
+func (hg HandGroup) SortWith(e Evaluator) {
+	values := make(map[*Hand][]int, len(hg))
+	for _, h := range hg {
+		values[h] = e.Rank(*h).Value()
+	}
+	sort.SliceStable(hg, func(i, j int) bool {
+		return compareValues(values[hg[i]], values[hg[j]]) < 0
+	})
+}
+
+// True if the evaluator ranks hands with jokers in them, as wild cards
+func allowsJokers(e Evaluator) bool {
+	switch e := e.(type) {
+	case WildRules:
+		return true
+	case OmahaRules:
+		return allowsJokers(e.ranking())
+	}
+	return false
+}
+
+// The cards of both sets together, in a new set
+func (c CardSet) union(other CardSet) CardSet {
+	return append(append(CardSet{}, c...), other...)
+}
@@ -0,0 +1,60 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Evaluators", func() {
+	It("rank hands made with them", func() {
+		c := mustParse("Ac 2d 3h 4s 5c")
+		Expect(NewHandWith(High, c[0], c[1], c[2], c[3], c[4]).Rank().Category()).To(Equal(Straight))
+		Expect(NewHandWith(DeuceToSeven, c[0], c[1], c[2], c[3], c[4]).Rank().Category()).To(Equal(HighCard))
+		Expect(High.Rank(*straight)).To(Equal(straight.Rank()))
+	})
+
+	It("sort hands regardless of how they were made", func() {
+		hands := HandGroup{pair, highCard, straight}
+		hands.SortWith(DeuceToSeven)
+		Expect(hands).To(Equal(HandGroup{straight, pair, highCard}))
+		hands.SortWith(High)
+		Expect(hands).To(Equal(HandGroup{highCard, pair, straight}))
+	})
+
+	It("find the best hand from hole cards and a board", func() {
+		hand, err := mustParse("Kc Kd 7h 5s 3c 2d Ac").BestPossibleHandWith(AceToFive)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Seven}))
+
+		hand, err = OmahaLow.BestHand(mustParse("Ac 2d Kh Ks"), mustParse("3c 4d 5h 9s Qc"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Five}))
+		Expect(hand.QualifiesForLow(EightOrBetter)).To(BeTrue())
+
+		hand, err = OmahaLow.BestHand(mustParse("Ac 2d Kh Ks"), mustParse("9c Td Jh 3s 4c"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.QualifiesForLow(EightOrBetter)).To(BeFalse())
+
+		_, err = mustParse("Ac 2d Kh Ks 3c 4d 5h").BestPossibleHandWith(Omaha)
+		Expect(err).To(MatchError(ErrNeedsHoleCards))
+	})
+
+	It("decide showdowns regardless of how hands were made", func() {
+		alice, bob := NewPlayer("Alice"), NewPlayer("Bob")
+		defer alice.MuckHand()
+		defer bob.MuckHand()
+		alice.GetHand(pair)
+		bob.GetHand(highCard)
+
+		Expect(MustWinnerTiers([]*Player{alice, bob})[0]).To(Equal([]*Player{alice}))
+		tiers, err := WinnerTiersWith([]*Player{alice, bob}, DeuceToSeven)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tiers[0]).To(Equal([]*Player{bob}))
+
+		payouts, _, err := ShowdownWith([]*Player{alice, bob}, []*Pot{NewPot(10, []*Player{alice, bob})}, DeuceToSeven)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payouts).To(Equal(map[*Player]int{bob: 10}))
+	})
+})
@@ -0,0 +1,113 @@
+package goker
+
+import (
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// Provably fair dealing works by commit and reveal. Before a hand, the
+// server picks a secret seed with NewServerSeed and publishes its
+// commitment from CommitSeed. Players may then contribute seeds of their
+// own, and the deck for the hand is NewProvablyFairDeck of all the seeds,
+// so neither side alone controls the order of the cards. After the hand
+// the server reveals its seed, and anyone can check the deal with
+// VerifyDeal.
+
+// NewServerSeed returns a new secret seed from crypto/rand for the server's
+// side of a provably fair deal
+func NewServerSeed() ([]byte, error) {
+	seed := make([]byte, 32)
+	if _, err := crand.Read(seed); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// CommitSeed returns the commitment to publish for a server seed before the
+// hand is dealt, the hex encoded SHA-256 hash of the seed
+func CommitSeed(serverSeed []byte) string {
+	sum := sha256.Sum256(serverSeed)
+	return hex.EncodeToString(sum[:])
+}
+
+// NewProvablyFairDeck constructs a standard 52 card deck whose order is
+// derived deterministically from the server seed and the client seeds, in
+// the order given
+func NewProvablyFairDeck(serverSeed []byte, clientSeeds [][]byte) *Deck {
+	return NewDeckWithShuffler(newSeedShuffler(serverSeed, clientSeeds))
+}
+
+// VerifyDeal checks that the revealed server seed matches the commitment
+// published before the hand, and that dealtCards are exactly the cards a
+// provably fair deck with the given seeds deals, in order. dealtCards must
+// include every card taken from the deck, burned cards too. The
+// commitment's hex digits may be in either case. It returns
+// ErrCommitmentMismatch or ErrDealMismatch if either check fails.
+func VerifyDeal(serverSeed []byte, clientSeeds [][]byte, commitment string, dealtCards CardSet) error {
+	committed, err := hex.DecodeString(commitment)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCommitmentMismatch, err)
+	}
+	sum := sha256.Sum256(serverSeed)
+	if !hmac.Equal(sum[:], committed) {
+		return ErrCommitmentMismatch
+	}
+
+	expected, err := NewProvablyFairDeck(serverSeed, clientSeeds).Draw(len(dealtCards))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDealMismatch, err)
+	}
+	for i := range expected {
+		if dealtCards[i] == nil {
+			return fmt.Errorf("%w: card %d is missing", ErrDealMismatch, i+1)
+		}
+		if *expected[i] != *dealtCards[i] {
+			return fmt.Errorf("%w: card %d should be %s, was %s", ErrDealMismatch, i+1, expected[i], dealtCards[i])
+		}
+	}
+	return nil
+}
+
+// A Shuffler drawing from an HMAC-SHA256 keystream keyed by the hash of
+// all the seeds. Each seed is length prefixed, so that no two different
+// lists of seeds produce the same key.
+type seedShuffler struct {
+	key     []byte
+	counter uint64
+	block   []byte
+}
+
+func newSeedShuffler(serverSeed []byte, clientSeeds [][]byte) *seedShuffler {
+	h := sha256.New()
+	for _, seed := range append([][]byte{serverSeed}, clientSeeds...) {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(seed)))
+		h.Write(length[:])
+		h.Write(seed)
+	}
+	return &seedShuffler{key: h.Sum(nil)}
+}
+
+func (s *seedShuffler) Intn(n int) int {
+	return uniformIntn(n, s.next)
+}
+
+func (s *seedShuffler) next() uint64 {
+	if len(s.block) == 0 {
+		var counter [8]byte
+		binary.BigEndian.PutUint64(counter[:], s.counter)
+		s.counter++
+
+		mac := hmac.New(sha256.New, s.key)
+		mac.Write(counter[:])
+		s.block = mac.Sum(nil)
+	}
+
+	v := binary.BigEndian.Uint64(s.block)
+	s.block = s.block[8:]
+	return v
+}
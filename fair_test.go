@@ -0,0 +1,101 @@
+package goker_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Provably fair dealing", func() {
+	serverSeed := []byte("server seed")
+	clientSeeds := [][]byte{[]byte("charlie"), []byte("dennis")}
+	commitment := CommitSeed(serverSeed)
+
+	deal := func(seed []byte, clients [][]byte) CardSet {
+		deck := NewProvablyFairDeck(seed, clients)
+		dealt := CardSet{}
+		burn, _ := deck.Burn()
+		dealt = append(dealt, burn)
+		flop, _ := deck.Draw(3)
+		return append(dealt, flop...)
+	}
+
+	It("commits to the server seed with its hash", func() {
+		Expect(commitment).To(HaveLen(64))
+		Expect(CommitSeed([]byte("server seed"))).To(Equal(commitment))
+		Expect(CommitSeed([]byte("other seed"))).NotTo(Equal(commitment))
+	})
+
+	It("generates random server seeds", func() {
+		seed, err := NewServerSeed()
+		Expect(err).NotTo(HaveOccurred())
+		other, _ := NewServerSeed()
+		Expect(seed).To(HaveLen(32))
+		Expect(seed).NotTo(Equal(other))
+	})
+
+	It("derives the deck order from all of the seeds", func() {
+		Expect(deal(serverSeed, clientSeeds)).To(Equal(deal(serverSeed, clientSeeds)))
+		Expect(deal(serverSeed, clientSeeds)).NotTo(Equal(deal(serverSeed, clientSeeds[:1])))
+		Expect(deal(serverSeed, clientSeeds)).NotTo(Equal(deal([]byte("other seed"), clientSeeds)))
+		Expect(deal(serverSeed, [][]byte{[]byte("ab"), []byte("c")})).
+			NotTo(Equal(deal(serverSeed, [][]byte{[]byte("a"), []byte("bc")})))
+	})
+
+	It("deals a full deck", func() {
+		cards, _ := NewProvablyFairDeck(serverSeed, clientSeeds).Draw(52)
+		Expect(cards.Mask()).To(Equal(FullDeckMask))
+	})
+
+	Describe("verifying a deal", func() {
+		dealt := deal(serverSeed, clientSeeds)
+
+		It("accepts an honest deal", func() {
+			Expect(VerifyDeal(serverSeed, clientSeeds, commitment, dealt)).To(Succeed())
+		})
+
+		It("accepts a commitment in upper case", func() {
+			Expect(VerifyDeal(serverSeed, clientSeeds, strings.ToUpper(commitment), dealt)).To(Succeed())
+		})
+
+		It("rejects a commitment that isn't hex", func() {
+			err := VerifyDeal(serverSeed, clientSeeds, "not hex", dealt)
+			Expect(errors.Is(err, ErrCommitmentMismatch)).To(BeTrue())
+		})
+
+		It("rejects a server seed that wasn't committed to", func() {
+			err := VerifyDeal([]byte("other seed"), clientSeeds, commitment, dealt)
+			Expect(err).To(MatchError(ErrCommitmentMismatch))
+		})
+
+		It("rejects cards that weren't dealt by the seeds", func() {
+			tampered := append(CardSet{}, dealt...)
+			tampered[1], tampered[2] = tampered[2], tampered[1]
+			err := VerifyDeal(serverSeed, clientSeeds, commitment, tampered)
+			Expect(errors.Is(err, ErrDealMismatch)).To(BeTrue())
+		})
+
+		It("rejects a missing card", func() {
+			missing := append(CardSet{}, dealt...)
+			missing[3] = nil
+			err := VerifyDeal(serverSeed, clientSeeds, commitment, missing)
+			Expect(errors.Is(err, ErrDealMismatch)).To(BeTrue())
+		})
+
+		It("rejects a deal that ignored a client seed", func() {
+			err := VerifyDeal(serverSeed, clientSeeds, commitment, deal(serverSeed, nil))
+			Expect(errors.Is(err, ErrDealMismatch)).To(BeTrue())
+		})
+
+		It("rejects more cards than a deck holds", func() {
+			tooMany, _ := NewProvablyFairDeck(serverSeed, clientSeeds).Draw(52)
+			tooMany = append(tooMany, tooMany[0])
+			err := VerifyDeal(serverSeed, clientSeeds, commitment, tooMany)
+			Expect(errors.Is(err, ErrDealMismatch)).To(BeTrue())
+		})
+	})
+})
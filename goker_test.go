@@ -17,4 +17,37 @@ var _ = Describe("Goker", func() {
 		Expect(tenOfDiamonds.Rank).To(Equal(Ten))
 		Expect(tenOfDiamonds.Suit).To(Equal(Diamond))
 	})
+
+	It("enumerates every rank and suit", func() {
+		Expect(AllRanks()).To(HaveLen(13))
+		Expect(AllRanks()[0]).To(Equal(Two))
+		Expect(AllRanks()[12]).To(Equal(Ace))
+		Expect(AllSuits()).To(Equal([]Suit{Spade, Heart, Diamond, Club}))
+	})
+
+	It("steps between ranks, with aces playing high and low", func() {
+		Expect(Nine.Next()).To(Equal(Ten))
+		Expect(Nine.Prev()).To(Equal(Eight))
+		Expect(Ace.Next()).To(Equal(Two))
+		Expect(Two.Prev()).To(Equal(Ace))
+	})
+
+	It("doesn't step away from a joker", func() {
+		Expect(Joker.Next()).To(Equal(Joker))
+		Expect(Joker.Prev()).To(Equal(Joker))
+	})
+
+	It("has ASCII characters for ranks and suits", func() {
+		Expect(Ten.Char()).To(Equal(byte('T')))
+		Expect(Seven.Char()).To(Equal(byte('7')))
+		Expect(Heart.Char()).To(Equal(byte('h')))
+		Expect(Club.Char()).To(Equal(byte('c')))
+	})
+
+	It("has red and black suits", func() {
+		Expect(Spade.Color()).To(Equal(Black))
+		Expect(Club.Color()).To(Equal(Black))
+		Expect(Heart.Color()).To(Equal(Red))
+		Expect(Diamond.Color()).To(Equal(Red))
+	})
 })
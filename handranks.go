@@ -1,6 +1,7 @@
 package goker
 
 import (
+	"fmt"
 	"sort"
 )
 
@@ -8,32 +9,183 @@ import (
 // is determined by several sub-values, e.g. the ranks of
 // cards that make up a poker hand
 type HandRank interface {
+	// Value returns the sub-values that decide the hand, most
+	// significant first, compared in order. The hand's Strength can
+	// only be packed if there are at most six of them, each from 0 to
+	// 15, though hands are compared either way.
 	Value() []int
 	Name() string
+
+	// Category returns the kind of hand, e.g. FullHouse
+	Category() Category
+
+	// Primary returns the ranks that make up the hand, most
+	// significant first, e.g. the trips then the pair of a full
+	// house, all five ranks of a flush, or just the high card
+	// of a straight or a hand with nothing better
+	Primary() []Rank
+
+	// Kickers returns the ranks of the cards which play in the
+	// hand without being part of what was made, highest first
+	Kickers() []Rank
+}
+
+// Category is a kind of poker hand, e.g. Flush or TwoPair. Categories
+// are ordered from worst to best.
+type Category int8
+
+const (
+	// HighCard is a hand with none of the below
+	HighCard Category = iota
+	// Pair is a hand with two cards of the same rank
+	Pair
+	// TwoPair is a hand with two pairs of different ranks
+	TwoPair
+	// ThreeOfAKind is a hand with three cards of the same rank
+	ThreeOfAKind
+	// Straight is a hand of five consecutive ranks
+	Straight
+	// Flush is a hand of five cards of the same suit
+	Flush
+	// FullHouse is a hand with three of one rank and two of another
+	FullHouse
+	// FourOfAKind is a hand with four cards of the same rank
+	FourOfAKind
+	// StraightFlush is a straight whose cards are all the same suit
+	StraightFlush
+	// RoyalStraightFlush is an ace high straight flush
+	RoyalStraightFlush
+	// FiveOfAKind is a hand with five cards of the same rank, which can
+	// only be made with wild cards or more than one deck
+	FiveOfAKind
+)
+
+func (c Category) String() string {
+	switch c {
+	case HighCard:
+		return "HighCard"
+	case Pair:
+		return "Pair"
+	case TwoPair:
+		return "TwoPair"
+	case ThreeOfAKind:
+		return "ThreeOfAKind"
+	case Straight:
+		return "Straight"
+	case Flush:
+		return "Flush"
+	case FullHouse:
+		return "FullHouse"
+	case FourOfAKind:
+		return "FourOfAKind"
+	case StraightFlush:
+		return "StraightFlush"
+	case RoyalStraightFlush:
+		return "RoyalStraightFlush"
+	case FiveOfAKind:
+		return "FiveOfAKind"
+	default:
+		return "?"
+	}
 }
 
 // IsLessThan returns true if the receiver is of lower value
 // by the rules of poker than the hand provided
 func (h *Hand) IsLessThan(h2 *Hand) bool {
-	for idx := range h.Rank().Value() {
-		// Compare the value of each rank determiner, with decreasing
-		// significance, until one is higher
-		leftVal := h.Rank().Value()[idx]
-		rightVal := h2.Rank().Value()[idx]
-		if leftVal < rightVal {
-			return true
-		}
-		if leftVal > rightVal {
-			return false
-		}
-	}
-	return false
+	return Compare(h, h2) < 0
 }
 
 // IsEqual returns true if the receiver is of equal value
 // by the rules of poker to the hand provided
 func (h *Hand) IsEqual(h2 *Hand) bool {
-	return !h.IsLessThan(h2) && !h2.IsLessThan(h)
+	return Compare(h, h2) == 0
+}
+
+// Compare returns -1 if h1 is of lower value by the rules of poker
+// than h2, 1 if it is of higher value, and 0 if they are equal
+func Compare(h1, h2 *Hand) int {
+	return compareValues(h1.Rank().Value(), h2.Rank().Value())
+}
+
+// Compares two ranks' Value()s element by element, most significant
+// first, as for Compare. A missing element counts as zero, just as it
+// does when packed into a Strength, but values of any size and sign can
+// be compared, as custom evaluators may give.
+func compareValues(v1, v2 []int) int {
+	for i := 0; i < len(v1) || i < len(v2); i++ {
+		var a, b int
+		if i < len(v1) {
+			a = v1[i]
+		}
+		if i < len(v2) {
+			b = v2[i]
+		}
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Strength returns the value of the hand packed into a single integer,
+// which can be compared, hashed or stored in place of the hand's rank.
+// It panics if the rank's Value() doesn't fit in a Strength, which can
+// only happen with a custom Evaluator; hands are compared, sorted and
+// settled in a showdown without one.
+func (h Hand) Strength() Strength {
+	return rankStrength(h.Rank())
+}
+
+// Packs a rank's Value() into a Strength, the first element in bits
+// 20-23 and each of the rest in the next four bits down
+func rankStrength(r HandRank) Strength {
+	val := r.Value()
+	if len(val) == 0 || len(val) > 6 {
+		panic(fmt.Sprintf("goker: %s has %d values, a Strength holds 1 to 6", r.Name(), len(val)))
+	}
+
+	var s Strength
+	for i, v := range val {
+		if v < 0 || v > 15 {
+			panic(fmt.Sprintf("goker: %s has value %d, a Strength holds 0 to 15", r.Name(), v))
+		}
+		s |= Strength(v) << uint(20-4*i)
+	}
+	return s
+}
+
+// Five of a Kind
+
+type fiveOfAKind struct {
+	rank Rank
+}
+
+func newFiveOfAKind(rank Rank) *fiveOfAKind {
+	foak := fiveOfAKind{rank}
+	return &foak
+}
+
+func (foak fiveOfAKind) Value() []int {
+	return []int{10, int(foak.rank)}
+}
+
+func (foak fiveOfAKind) Name() string {
+	return "FiveOfAKind"
+}
+
+func (foak fiveOfAKind) Category() Category {
+	return FiveOfAKind
+}
+
+func (foak fiveOfAKind) Primary() []Rank {
+	return []Rank{foak.rank}
+}
+
+func (foak fiveOfAKind) Kickers() []Rank {
+	return []Rank{}
 }
 
 // Royal Straight Flush
@@ -53,13 +205,25 @@ func (rsf royalStraightFlush) Name() string {
 	return "RoyalStraightFlush"
 }
 
+func (rsf royalStraightFlush) Category() Category {
+	return RoyalStraightFlush
+}
+
+func (rsf royalStraightFlush) Primary() []Rank {
+	return []Rank{Ace}
+}
+
+func (rsf royalStraightFlush) Kickers() []Rank {
+	return nil
+}
+
 // Straight Flush
 
 type straightFlush struct {
-	highCard rank
+	highCard Rank
 }
 
-func newStraightFlush(highCard rank) *straightFlush {
+func newStraightFlush(highCard Rank) *straightFlush {
 	sf := straightFlush{highCard}
 	return &sf
 }
@@ -72,13 +236,25 @@ func (sf straightFlush) Name() string {
 	return "StraightFlush"
 }
 
+func (sf straightFlush) Category() Category {
+	return StraightFlush
+}
+
+func (sf straightFlush) Primary() []Rank {
+	return []Rank{sf.highCard}
+}
+
+func (sf straightFlush) Kickers() []Rank {
+	return nil
+}
+
 // Four of a kind
 
 type fourOfAKind struct {
-	quad, kicker rank
+	quad, kicker Rank
 }
 
-func newFourOfAKind(quadCard, kicker rank) *fourOfAKind {
+func newFourOfAKind(quadCard, kicker Rank) *fourOfAKind {
 	foak := fourOfAKind{quadCard, kicker}
 	return &foak
 }
@@ -91,13 +267,25 @@ func (foak fourOfAKind) Name() string {
 	return "FourOfAKind"
 }
 
+func (foak fourOfAKind) Category() Category {
+	return FourOfAKind
+}
+
+func (foak fourOfAKind) Primary() []Rank {
+	return []Rank{foak.quad}
+}
+
+func (foak fourOfAKind) Kickers() []Rank {
+	return []Rank{foak.kicker}
+}
+
 // Full House
 
 type fullHouse struct {
-	trip, pair rank
+	trip, pair Rank
 }
 
-func newFullHouse(trip, pair rank) *fullHouse {
+func newFullHouse(trip, pair Rank) *fullHouse {
 	fh := fullHouse{trip, pair}
 	return &fh
 }
@@ -110,13 +298,25 @@ func (fh fullHouse) Name() string {
 	return "FullHouse"
 }
 
+func (fh fullHouse) Category() Category {
+	return FullHouse
+}
+
+func (fh fullHouse) Primary() []Rank {
+	return []Rank{fh.trip, fh.pair}
+}
+
+func (fh fullHouse) Kickers() []Rank {
+	return nil
+}
+
 // Flush
 
 type flush struct {
-	ranks []rank
+	ranks []Rank
 }
 
-func newFlush(ranks []rank) *flush {
+func newFlush(ranks []Rank) *flush {
 	f := flush{ranks}
 	return &f
 }
@@ -131,13 +331,25 @@ func (f flush) Name() string {
 	return "Flush"
 }
 
+func (f flush) Category() Category {
+	return Flush
+}
+
+func (f flush) Primary() []Rank {
+	return sortedHighToLow(f.ranks)
+}
+
+func (f flush) Kickers() []Rank {
+	return nil
+}
+
 // Straight
 
 type straight struct {
-	highCard rank
+	highCard Rank
 }
 
-func newStraight(highCard rank) *straight {
+func newStraight(highCard Rank) *straight {
 	s := straight{highCard}
 	return &s
 }
@@ -150,14 +362,26 @@ func (s straight) Name() string {
 	return "Straight"
 }
 
+func (s straight) Category() Category {
+	return Straight
+}
+
+func (s straight) Primary() []Rank {
+	return []Rank{s.highCard}
+}
+
+func (s straight) Kickers() []Rank {
+	return nil
+}
+
 // Three of a Kind
 
 type threeOfAKind struct {
-	trip    rank
-	kickers []rank
+	trip    Rank
+	kickers []Rank
 }
 
-func newThreeOfAKind(trip rank, kickers []rank) *threeOfAKind {
+func newThreeOfAKind(trip Rank, kickers []Rank) *threeOfAKind {
 	toak := threeOfAKind{trip, kickers}
 	return &toak
 }
@@ -172,19 +396,31 @@ func (toak threeOfAKind) Name() string {
 	return "ThreeOfAKind"
 }
 
+func (toak threeOfAKind) Category() Category {
+	return ThreeOfAKind
+}
+
+func (toak threeOfAKind) Primary() []Rank {
+	return []Rank{toak.trip}
+}
+
+func (toak threeOfAKind) Kickers() []Rank {
+	return sortedHighToLow(toak.kickers)
+}
+
 // Two Pair
 
 type twoPair struct {
-	pair1, pair2, kicker rank
+	pair1, pair2, kicker Rank
 }
 
-func newTwoPair(pair1, pair2, kicker rank) *twoPair {
+func newTwoPair(pair1, pair2, kicker Rank) *twoPair {
 	tp := twoPair{pair1, pair2, kicker}
 	return &tp
 }
 
 func (tp twoPair) Value() []int {
-	pairs := []rank{tp.pair1, tp.pair2}
+	pairs := []Rank{tp.pair1, tp.pair2}
 	intRanks := rankSliceToSortedIntSlice(pairs)
 	intRanks = append([]int{2}, intRanks...)
 
@@ -195,14 +431,26 @@ func (tp twoPair) Name() string {
 	return "TwoPair"
 }
 
+func (tp twoPair) Category() Category {
+	return TwoPair
+}
+
+func (tp twoPair) Primary() []Rank {
+	return sortedHighToLow([]Rank{tp.pair1, tp.pair2})
+}
+
+func (tp twoPair) Kickers() []Rank {
+	return []Rank{tp.kicker}
+}
+
 // Pair
 
 type onePair struct {
-	pair    rank
-	kickers []rank
+	pair    Rank
+	kickers []Rank
 }
 
-func newPair(pair rank, kickers []rank) *onePair {
+func newPair(pair Rank, kickers []Rank) *onePair {
 	p := onePair{pair, kickers}
 	return &p
 }
@@ -218,13 +466,25 @@ func (p onePair) Name() string {
 	return "Pair"
 }
 
+func (p onePair) Category() Category {
+	return Pair
+}
+
+func (p onePair) Primary() []Rank {
+	return []Rank{p.pair}
+}
+
+func (p onePair) Kickers() []Rank {
+	return sortedHighToLow(p.kickers)
+}
+
 // High Card
 
 type highCard struct {
-	ranks []rank
+	ranks []Rank
 }
 
-func newHighCard(ranks []rank) *highCard {
+func newHighCard(ranks []Rank) *highCard {
 	hc := highCard{ranks}
 	return &hc
 }
@@ -239,8 +499,50 @@ func (hc highCard) Name() string {
 	return "HighCard"
 }
 
+func (hc highCard) Category() Category {
+	return HighCard
+}
+
+func (hc highCard) Primary() []Rank {
+	return sortedHighToLow(hc.ranks)[:1]
+}
+
+func (hc highCard) Kickers() []Rank {
+	return sortedHighToLow(hc.ranks)[1:]
+}
+
+// Unrankable
+
+// The rank of a hand holding a joker by rules without wild cards. Its
+// value is below that of every hand that can be ranked.
+type unrankable struct{}
+
+func newUnrankable() *unrankable {
+	return &unrankable{}
+}
+
+func (u unrankable) Value() []int {
+	return []int{0}
+}
+
+func (u unrankable) Name() string {
+	return "Unrankable"
+}
+
+func (u unrankable) Category() Category {
+	return HighCard
+}
+
+func (u unrankable) Primary() []Rank {
+	return []Rank{}
+}
+
+func (u unrankable) Kickers() []Rank {
+	return []Rank{}
+}
+
 // Helper func to sort ranks high to low for use in a rank's Value()
-func rankSliceToSortedIntSlice(s []rank) []int {
+func rankSliceToSortedIntSlice(s []Rank) []int {
 	ints := make([]int, len(s))
 	for i := range s {
 		ints[i] = int(s[i])
@@ -248,3 +550,10 @@ func rankSliceToSortedIntSlice(s []rank) []int {
 	sort.Sort(sort.Reverse(sort.IntSlice(ints)))
 	return ints
 }
+
+// Helper func to sort a copy of ranks high to low
+func sortedHighToLow(ranks []Rank) []Rank {
+	sorted := append([]Rank{}, ranks...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	return sorted
+}
@@ -256,3 +256,176 @@ var _ = Describe("When comparing hands", func() {
 		})
 	})
 })
+
+var _ = Describe("The components of a hand's rank", func() {
+	It("gives the category of every kind of hand", func() {
+		Expect(royalStraightFlush.Rank().Category()).To(Equal(RoyalStraightFlush))
+		Expect(straightFlush.Rank().Category()).To(Equal(StraightFlush))
+		Expect(fourOfAKind.Rank().Category()).To(Equal(FourOfAKind))
+		Expect(fullHouse.Rank().Category()).To(Equal(FullHouse))
+		Expect(flush.Rank().Category()).To(Equal(Flush))
+		Expect(straight.Rank().Category()).To(Equal(Straight))
+		Expect(threeOfAKind.Rank().Category()).To(Equal(ThreeOfAKind))
+		Expect(twoPair.Rank().Category()).To(Equal(TwoPair))
+		Expect(pair.Rank().Category()).To(Equal(Pair))
+		Expect(highCard.Rank().Category()).To(Equal(HighCard))
+	})
+
+	It("orders categories from worst to best", func() {
+		Expect(HighCard).To(BeNumerically("<", Pair))
+		Expect(Straight).To(BeNumerically("<", Flush))
+		Expect(StraightFlush).To(BeNumerically("<", RoyalStraightFlush))
+	})
+
+	It("gives the primary ranks and kickers", func() {
+		Expect(fourOfAKind.Rank().Primary()).To(Equal([]Rank{Ten}))
+		Expect(fourOfAKind.Rank().Kickers()).To(Equal([]Rank{Nine}))
+
+		Expect(fullHouse.Rank().Primary()).To(Equal([]Rank{Ten, Nine}))
+		Expect(fullHouse.Rank().Kickers()).To(BeEmpty())
+
+		Expect(flush.Rank().Primary()).To(Equal([]Rank{King, Queen, Seven, Five, Two}))
+		Expect(aceLowStraight.Rank().Primary()).To(Equal([]Rank{Five}))
+
+		Expect(twoPair.Rank().Primary()).To(Equal([]Rank{Seven, Four}))
+		Expect(twoPair.Rank().Kickers()).To(Equal([]Rank{Nine}))
+
+		Expect(pair.Rank().Primary()).To(Equal([]Rank{Four}))
+		Expect(pair.Rank().Kickers()).To(Equal([]Rank{Nine, Eight, Seven}))
+
+		Expect(highCard.Rank().Primary()).To(Equal([]Rank{Nine}))
+		Expect(highCard.Rank().Kickers()).To(Equal([]Rank{Eight, Seven, Four, Two}))
+	})
+
+	It("splits a hand's cards into those that make the hand and kickers", func() {
+		made, kickers := threeOfAKind.Split()
+		Expect(made).To(ConsistOf(Card{Four, Club}, Card{Four, Heart}, Card{Four, Spade}))
+		Expect(kickers).To(Equal([]Card{{Seven, Club}, {Nine, Club}}))
+
+		made, kickers = twoPair.Split()
+		Expect(made).To(HaveLen(4))
+		Expect(kickers).To(Equal([]Card{{Nine, Club}}))
+
+		made, kickers = highCard.Split()
+		Expect(made).To(Equal([]Card{{Nine, Club}}))
+		Expect(kickers).To(HaveLen(4))
+
+		made, kickers = fullHouse.Split()
+		Expect(made).To(HaveLen(5))
+		Expect(kickers).To(BeEmpty())
+	})
+})
+
+var _ = Describe("The total ordering of hands", func() {
+	It("names each category like the ranks of its hands", func() {
+		for _, h := range []*Hand{royalStraightFlush, straightFlush, fourOfAKind, fullHouse, flush,
+			straight, threeOfAKind, twoPair, pair, highCard} {
+			Expect(h.Rank().Category().String()).To(Equal(h.Rank().Name()))
+		}
+	})
+
+	It("compares hands three ways", func() {
+		Expect(Compare(pair, twoPair)).To(Equal(-1))
+		Expect(Compare(twoPair, pair)).To(Equal(1))
+		Expect(Compare(royalStraightFlush, otherRoyalStraightFlush)).To(Equal(0))
+		Expect(Compare(aceLowStraight, straight)).To(Equal(-1))
+	})
+
+	It("gives equal hands equal strengths and better hands greater ones", func() {
+		Expect(royalStraightFlush.Strength()).To(Equal(otherRoyalStraightFlush.Strength()))
+		Expect(flush.Strength()).To(BeNumerically(">", straight.Strength()))
+		Expect(fullHouse.Strength().Category()).To(Equal(FullHouse))
+		Expect(aceLowStraight.Strength().Category()).To(Equal(Straight))
+	})
+
+	It("packs a hand's strength like the mask evaluator", func() {
+		for _, h := range []*Hand{fourOfAKind, aceLowStraight, twoPair, highCard} {
+			var mask CardMask
+			for _, c := range h.Cards {
+				mask = mask.Add(c.Pack())
+			}
+			Expect(h.Strength()).To(Equal(mask.Strength()))
+		}
+	})
+
+	It("fits the values of every ranking in a strength", func() {
+		hands := []*Hand{royalStraightFlush, straightFlush, fourOfAKind, fullHouse, flush,
+			straight, aceLowStraight, threeOfAKind, twoPair, pair, highCard}
+		for _, e := range []Evaluator{High, AceToFive, DeuceToSeven, ShortDeck, Omaha, DeucesWild} {
+			for _, h := range hands {
+				val := e.Rank(*h).Value()
+				Expect(len(val)).To(BeNumerically("<=", 6))
+				for _, v := range val {
+					Expect(v).To(And(BeNumerically(">=", 0), BeNumerically("<=", 15)), h.Rank().Name())
+				}
+			}
+		}
+	})
+
+	It("won't pack a ranking whose values don't fit", func() {
+		c := mustParse("As Ks Qs Js 9d")
+		Expect(func() {
+			NewHandWith(badEvaluator{[]int{0, 16}}, c[0], c[1], c[2], c[3], c[4]).Strength()
+		}).To(Panic())
+		Expect(func() {
+			NewHandWith(badEvaluator{[]int{0, -1}}, c[0], c[1], c[2], c[3], c[4]).Strength()
+		}).To(Panic())
+		Expect(func() {
+			NewHandWith(badEvaluator{[]int{0, 1, 2, 3, 4, 5, 6}}, c[0], c[1], c[2], c[3], c[4]).Strength()
+		}).To(Panic())
+	})
+
+	It("compares and settles rankings whose values don't fit", func() {
+		wide := func(h *Hand) *Hand {
+			c := h.Cards
+			return NewHandWith(wideEvaluator{}, &c[0], &c[1], &c[2], &c[3], &c[4])
+		}
+		Expect(Compare(wide(pair), wide(twoPair))).To(Equal(-1))
+		Expect(wide(flush).IsLessThan(wide(straight))).To(BeFalse())
+		Expect(wide(flush).IsEqual(wide(flush))).To(BeTrue())
+
+		alice, bob := NewPlayer("Alice"), NewPlayer("Bob")
+		alice.GetHand(wide(fullHouse))
+		bob.GetHand(wide(threeOfAKind))
+		payouts, _, err := Showdown([]*Player{alice, bob}, []*Pot{NewPot(10, []*Player{alice, bob})})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payouts).To(Equal(map[*Player]int{alice: 10}))
+	})
+})
+
+// Ranks hands like High, with values too large and too many for a Strength
+type wideEvaluator struct{}
+
+func (wideEvaluator) Rank(h Hand) HandRank {
+	val := []int{-1}
+	for _, v := range High.Rank(h).Value() {
+		val = append(val, v*100)
+	}
+	return badRank{High.Rank(h), append(val, 0, 0, 0, 0, 0, 0)}
+}
+
+func (wideEvaluator) BestHand(hole, board CardSet) (*Hand, error) {
+	return nil, nil
+}
+
+// Ranks every hand with the same, possibly invalid, value
+type badEvaluator struct {
+	value []int
+}
+
+func (e badEvaluator) Rank(h Hand) HandRank {
+	return badRank{High.Rank(h), e.value}
+}
+
+func (e badEvaluator) BestHand(hole, board CardSet) (*Hand, error) {
+	return nil, nil
+}
+
+type badRank struct {
+	HandRank
+	value []int
+}
+
+func (r badRank) Value() []int {
+	return r.value
+}
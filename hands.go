@@ -9,14 +9,23 @@ import (
 type Hand struct {
 	Cards [5]Card
 	owner *Player
+
+	// Ranks the hand, by high hand rules if nil
+	evaluator Evaluator
 }
 
 // NewHand returns a pointer to a new hand consisting of
 // the provided cards
 func NewHand(card1, card2, card3, card4, card5 *Card) *Hand {
-	h := Hand{[5]Card{*card1, *card2, *card3, *card4, *card5}, nil}
-	sort.Sort(&h)
-	return &h
+	return NewHandWith(nil, card1, card2, card3, card4, card5)
+}
+
+// NewHandWith returns a pointer to a new hand consisting of the provided
+// cards, ranked by the given evaluator. Hands should only be compared
+// with others ranked by the same evaluator. A hand holding a joker can
+// only be ranked by wild card rules; see Rank.
+func NewHandWith(e Evaluator, card1, card2, card3, card4, card5 *Card) *Hand {
+	return newHand(e, CardSet{card1, card2, card3, card4, card5})
 }
 
 // NewHandFromSet returns a pointer to a new hand consisting of
@@ -25,9 +34,34 @@ func NewHandFromSet(cards CardSet) *Hand {
 	return NewHand(cards[0], cards[1], cards[2], cards[3], cards[4])
 }
 
+// Makes a sorted hand of the first five cards, ranked by the evaluator
+func newHand(e Evaluator, cards CardSet) *Hand {
+	h := Hand{Cards: [5]Card{*cards[0], *cards[1], *cards[2], *cards[3], *cards[4]}, evaluator: e}
+	sort.Sort(&h)
+	return &h
+}
+
 // Rank returns a struct representing the value of the hand according to
-// the rules of poker
+// the rules of poker, or those of the evaluator the hand was made with.
+// Jokers can only be ranked by wild card rules, so by any others a hand
+// holding one is unrankable: it ranks below every other hand, and
+// Showdown and WinnerTiers return ErrUnexpectedJoker for it.
 func (h Hand) Rank() HandRank {
+	if h.evaluator == nil {
+		return h.highRank()
+	}
+	return h.evaluator.Rank(h)
+}
+
+func (h Hand) highRank() HandRank {
+	if h.hasJoker() {
+		return newUnrankable()
+	}
+
+	if fives := h.groupsOf(5); len(fives) != 0 {
+		return newFiveOfAKind(fives[0])
+	}
+
 	if h.isFlush() && h.isStraight() {
 		if h.highCard().Rank == Ace {
 			return newRoyalStraightFlush()
@@ -74,10 +108,52 @@ func (h Hand) Rank() HandRank {
 	return newHighCard(h.ranks())
 }
 
+// Split divides the hand's cards into those that make up the hand and
+// those that are only kickers, e.g. the three cards of the trips and the
+// two kickers in a three of a kind. Both are ordered low to high.
+func (h Hand) Split() (made []Card, kickers []Card) {
+	rank := h.Rank()
+	switch rank.Category() {
+	case Straight, Flush, FullHouse, StraightFlush, RoyalStraightFlush, FiveOfAKind:
+		return append([]Card{}, h.Cards[:]...), []Card{}
+	}
+
+	primary := make(map[Rank]bool)
+	for _, r := range rank.Primary() {
+		primary[r] = true
+	}
+
+	// Wild cards count as the cards they played as
+	played := h.Cards
+	if w, ok := rank.(*wildRank); ok {
+		played = w.played
+	}
+
+	made, kickers = []Card{}, []Card{}
+	for i, card := range h.Cards {
+		if primary[played[i].Rank] {
+			made = append(made, card)
+		} else {
+			kickers = append(kickers, card)
+		}
+	}
+	return made, kickers
+}
+
+// True if any of the hand's cards is a joker
+func (h Hand) hasJoker() bool {
+	for _, c := range h.Cards {
+		if c.IsJoker() {
+			return true
+		}
+	}
+	return false
+}
+
 func (h Hand) isFlush() bool {
-	suit := h.Cards[0].Suit
+	s := h.Cards[0].Suit
 	for _, c := range h.Cards {
-		if c.Suit != suit {
+		if c.Suit != s {
 			return false
 		}
 	}
@@ -109,16 +185,16 @@ func (h Hand) isStraight() bool {
 // The order of the ranks in the slice returned is undefined.
 // Examples: QQQKK.groupsOf(2) -> [K], KKQQQ.groupsOf(3) -> [Q]
 //           7TTAA.groupsOf(2) -> [T, A]
-func (h Hand) groupsOf(n int) []rank {
-	m := make(map[rank]int)
+func (h Hand) groupsOf(n int) []Rank {
+	m := make(map[Rank]int)
 	for _, card := range h.Cards {
 		m[card.Rank]++
 	}
 
-	s := make([]rank, 0)
-	for rank, count := range m {
+	s := make([]Rank, 0)
+	for r, count := range m {
 		if count == n {
-			s = append(s, rank)
+			s = append(s, r)
 		}
 	}
 
@@ -126,8 +202,8 @@ func (h Hand) groupsOf(n int) []rank {
 }
 
 // Returns the cards in a hand grouped by rank
-func (h Hand) rankGroups() map[rank][]Card {
-	m := make(map[rank][]Card)
+func (h Hand) rankGroups() map[Rank][]Card {
+	m := make(map[Rank][]Card)
 	for _, card := range h.Cards {
 		m[card.Rank] = append(m[card.Rank], card)
 	}
@@ -137,10 +213,10 @@ func (h Hand) rankGroups() map[rank][]Card {
 // Returns a slice of the cards that would remain if all the specified
 // ranks were removed
 // e.g. [9♠︎, 9♦︎, K♠︎, K♦︎, A♦︎].removeRanks(A, 9) -> [K♠︎, K♦︎]
-func (h Hand) removeRanks(ranks ...rank) []Card {
+func (h Hand) removeRanks(ranks ...Rank) []Card {
 	groups := h.rankGroups()
-	for _, rank := range ranks {
-		delete(groups, rank)
+	for _, r := range ranks {
+		delete(groups, r)
 	}
 
 	filtered := []Card{}
@@ -152,7 +228,7 @@ func (h Hand) removeRanks(ranks ...rank) []Card {
 
 // True if two hands are equal disregarding suit
 func (h Hand) equalRanks(otherHand *Hand) bool {
-	m1, m2 := make(map[rank]int), make(map[rank]int)
+	m1, m2 := make(map[Rank]int), make(map[Rank]int)
 
 	for i := range h.Cards {
 		m1[h.Cards[i].Rank]++
@@ -183,13 +259,13 @@ func (h Hand) highCard() Card {
 	return cards[len(cards)-1]
 }
 
-func (h Hand) ranks() []rank {
+func (h Hand) ranks() []Rank {
 	cards := h.Cards[0:]
 	return ranks(cards)
 }
 
-func ranks(cards []Card) []rank {
-	ranks := make([]rank, 5)
+func ranks(cards []Card) []Rank {
+	ranks := make([]Rank, len(cards))
 	for i, card := range cards {
 		ranks[i] = card.Rank
 	}
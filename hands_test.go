@@ -96,6 +96,19 @@ var _ = Describe("Hands", func() {
 		It("sorts the cards low to high", func() {
 			Expect(&hand.Cards[4]).To(Equal(NewCard(Queen, Diamond)))
 		})
+
+		It("only ranks a joker with wild card rules", func() {
+			cards := mustParse("Ad Kd Qd Jd")
+			other := mustParse("2c 3d 4h 7s 9c")
+			for _, e := range []Evaluator{nil, High, AceToFive, DeuceToSeven, ShortDeck} {
+				h := NewHandWith(e, cards[0], cards[1], cards[2], cards[3], NewJoker(Red))
+				Expect(h.Rank().Name()).To(Equal("Unrankable"))
+				Expect(h.Describe()).To(Equal("Unrankable without Wild Cards"))
+				Expect(h.IsLessThan(NewHandWith(e, other[0], other[1], other[2], other[3], other[4]))).To(BeTrue())
+			}
+			h := NewHandWith(JokersWild, cards[0], cards[1], cards[2], cards[3], NewJoker(Red))
+			Expect(h.Rank().Category()).To(Equal(RoyalStraightFlush))
+		})
 	})
 
 	Describe("Identifying hands", func() {
@@ -159,4 +172,11 @@ var _ = Describe("Hands", func() {
 			})
 		})
 	})
+
+	Describe("Valuing hands", func() {
+		It("values only the ranks of the cards in the hand", func() {
+			Expect(threeOfAKind.Rank().Value()).To(Equal([]int{3, 4, 9, 7}))
+			Expect(pair.Rank().Value()).To(Equal([]int{1, 4, 9, 8, 7}))
+		})
+	})
 })
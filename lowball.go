@@ -0,0 +1,246 @@
+package goker
+
+import "sort"
+
+var (
+	// AceToFive ranks hands by ace-to-five lowball rules, as for
+	// NewAceToFiveHand
+	AceToFive Evaluator = aceToFiveEvaluator{}
+
+	// DeuceToSeven ranks hands by deuce-to-seven lowball rules, as for
+	// NewDeuceToSevenHand
+	DeuceToSeven Evaluator = deuceToSevenEvaluator{}
+)
+
+type aceToFiveEvaluator struct{}
+
+func (aceToFiveEvaluator) Rank(h Hand) HandRank {
+	return h.aceToFiveRank()
+}
+
+func (e aceToFiveEvaluator) BestHand(hole, board CardSet) (*Hand, error) {
+	return hole.union(board).bestHand(e)
+}
+
+type deuceToSevenEvaluator struct{}
+
+func (deuceToSevenEvaluator) Rank(h Hand) HandRank {
+	return h.deuceToSevenRank()
+}
+
+func (e deuceToSevenEvaluator) BestHand(hole, board CardSet) (*Hand, error) {
+	return hole.union(board).bestHand(e)
+}
+
+// EightOrBetter is the highest card a low hand may hold to qualify for
+// the low half of the pot in most split pot games
+const EightOrBetter = Eight
+
+// NewAceToFiveHand returns a pointer to a new hand consisting of the
+// provided cards, ranked by ace-to-five lowball rules: aces are low,
+// straights and flushes don't count, and pairs count against the hand,
+// so the best hand is 5-4-3-2-A. Hands ranked this way should only be
+// compared with one another.
+func NewAceToFiveHand(card1, card2, card3, card4, card5 *Card) *Hand {
+	return NewHandWith(AceToFive, card1, card2, card3, card4, card5)
+}
+
+// BestAceToFiveHand returns the best 5 card ace-to-five low hand that
+// can be made with the cards in this set, e.g. the seven cards of a
+// hand of Razz, or ErrNotEnoughCards if there are fewer than 5 cards
+func (c CardSet) BestAceToFiveHand() (*Hand, error) {
+	return c.bestHand(AceToFive)
+}
+
+// QualifiesForLow returns true if the hand's cards are all of different
+// ranks, none higher than the given rank with aces counting low, e.g.
+// h.QualifiesForLow(EightOrBetter)
+func (h Hand) QualifiesForLow(highest Rank) bool {
+	seen := make(map[Rank]bool)
+	for _, card := range h.Cards {
+		if seen[card.Rank] || lowValue(card.Rank) > lowValue(highest) {
+			return false
+		}
+		seen[card.Rank] = true
+	}
+	return true
+}
+
+// NewDeuceToSevenHand returns a pointer to a new hand consisting of the
+// provided cards, ranked by deuce-to-seven lowball rules: the worst hand
+// by the rules of poker is the best, except that aces are always high,
+// so A-2-3-4-5 is not a straight. The best hand is 7-5-4-3-2 offsuit.
+// Hands ranked this way should only be compared with one another.
+func NewDeuceToSevenHand(card1, card2, card3, card4, card5 *Card) *Hand {
+	return NewHandWith(DeuceToSeven, card1, card2, card3, card4, card5)
+}
+
+// BestDeuceToSevenHand returns the best 5 card deuce-to-seven low hand
+// that can be made with the cards in this set, or ErrNotEnoughCards if
+// there are fewer than 5 cards
+func (c CardSet) BestDeuceToSevenHand() (*Hand, error) {
+	return c.bestHand(DeuceToSeven)
+}
+
+func (h Hand) aceToFiveRank() HandRank {
+	if h.hasJoker() {
+		return newUnrankable()
+	}
+
+	counts := make(map[Rank]int)
+	for _, card := range h.Cards {
+		counts[card.Rank]++
+	}
+
+	// Larger groups first, then the higher of two groups of the same size,
+	// since that is what decides between two low hands
+	groups := make([]Rank, 0, len(counts))
+	for r := range counts {
+		groups = append(groups, r)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if counts[groups[i]] != counts[groups[j]] {
+			return counts[groups[i]] > counts[groups[j]]
+		}
+		return lowValue(groups[i]) > lowValue(groups[j])
+	})
+
+	made := 0
+	for _, r := range groups {
+		if counts[r] > 1 {
+			made++
+		}
+	}
+
+	var category Category
+	switch {
+	case counts[groups[0]] == 5:
+		category = FiveOfAKind
+	case counts[groups[0]] == 4:
+		category = FourOfAKind
+	case counts[groups[0]] == 3 && made == 2:
+		category = FullHouse
+	case counts[groups[0]] == 3:
+		category = ThreeOfAKind
+	case made == 2:
+		category = TwoPair
+	case made == 1:
+		category = Pair
+	default:
+		category = HighCard
+	}
+	return newAceToFiveLow(category, groups)
+}
+
+// Ace-to-Five Low
+
+type aceToFiveLow struct {
+	category Category
+	groups   []Rank
+}
+
+func newAceToFiveLow(category Category, groups []Rank) *aceToFiveLow {
+	l := aceToFiveLow{category, groups}
+	return &l
+}
+
+// Lower categories and lower ranks are better, so both are inverted to
+// make better hands greater in value
+func (l aceToFiveLow) Value() []int {
+	val := []int{int(FiveOfAKind - l.category)}
+	for _, r := range l.groups {
+		val = append(val, 14-lowValue(r))
+	}
+	return val
+}
+
+func (l aceToFiveLow) Name() string {
+	if l.category == HighCard {
+		return "AceToFiveLow"
+	}
+	return l.category.String()
+}
+
+func (l aceToFiveLow) Category() Category {
+	return l.category
+}
+
+func (l aceToFiveLow) Primary() []Rank {
+	return l.groups[:l.primaryCount()]
+}
+
+func (l aceToFiveLow) Kickers() []Rank {
+	return l.groups[l.primaryCount():]
+}
+
+func (l aceToFiveLow) primaryCount() int {
+	switch l.category {
+	case TwoPair, FullHouse:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// The value of a rank when aces are low
+func lowValue(r Rank) int {
+	if r == Ace {
+		return 1
+	}
+	return int(r)
+}
+
+func (h Hand) deuceToSevenRank() HandRank {
+	if h.hasJoker() {
+		return newUnrankable()
+	}
+	if !h.isAceLowStraight() {
+		return newDeuceToSevenLow(h.highRank())
+	}
+	if h.isFlush() {
+		return newDeuceToSevenLow(newFlush(h.ranks()))
+	}
+	return newDeuceToSevenLow(newHighCard(h.ranks()))
+}
+
+// Deuce-to-Seven Low
+
+type deuceToSevenLow struct {
+	high HandRank
+}
+
+func newDeuceToSevenLow(high HandRank) *deuceToSevenLow {
+	l := deuceToSevenLow{high}
+	return &l
+}
+
+// The value of the hand by high hand rules, inverted so that worse high
+// hands are greater in value. Five of a kind, only possible with more
+// than one deck, is the worst hand of all.
+func (l deuceToSevenLow) Value() []int {
+	high := l.high.Value()
+	val := []int{int(FiveOfAKind) - high[0]}
+	for _, v := range high[1:] {
+		val = append(val, int(Ace)+2-v)
+	}
+	return val
+}
+
+func (l deuceToSevenLow) Name() string {
+	if l.high.Category() == HighCard {
+		return "DeuceToSevenLow"
+	}
+	return l.high.Name()
+}
+
+func (l deuceToSevenLow) Category() Category {
+	return l.high.Category()
+}
+
+func (l deuceToSevenLow) Primary() []Rank {
+	return l.high.Primary()
+}
+
+func (l deuceToSevenLow) Kickers() []Rank {
+	return l.high.Kickers()
+}
@@ -0,0 +1,127 @@
+package goker_test
+
+import (
+	"errors"
+	"sort"
+
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func aceToFive(s string) *Hand {
+	c := mustParse(s)
+	return NewAceToFiveHand(c[0], c[1], c[2], c[3], c[4])
+}
+
+var _ = Describe("Ace-to-five lowball", func() {
+	It("ranks the wheel as the best hand, ignoring the straight and flush", func() {
+		wheel := aceToFive("Ah 2h 3h 4h 5h")
+		Expect(wheel.Rank().Category()).To(Equal(HighCard))
+		Expect(aceToFive("Ac 2d 3h 4s 6c").IsLessThan(wheel)).To(BeTrue())
+	})
+
+	It("compares the highest cards first, aces low", func() {
+		Expect(Compare(aceToFive("7c 5d 4h 3s 2c"), aceToFive("7d 6c 3h 2s Ac"))).To(Equal(1))
+		Expect(Compare(aceToFive("8c 4d 3h 2s Ac"), aceToFive("7d 6c 5h 4s 3c"))).To(Equal(-1))
+		Expect(Compare(aceToFive("Kc Qd Jh Ts 9c"), aceToFive("Kd Qc Jc Tc 9h"))).To(Equal(0))
+	})
+
+	It("counts pairs against the hand", func() {
+		pair := aceToFive("Ac Ad 2h 3s 4c")
+		Expect(pair.Rank().Category()).To(Equal(Pair))
+		Expect(pair.IsLessThan(aceToFive("Kc Qd Jh Ts 9c"))).To(BeTrue())
+		Expect(aceToFive("2c 2d Ah 3s 4c").IsLessThan(pair)).To(BeTrue())
+		Expect(aceToFive("2c 2d 3h 3s 4c").IsLessThan(pair)).To(BeTrue())
+	})
+
+	It("ranks five of a kind from several decks worst of all", func() {
+		fiveAces := NewAceToFiveHand(NewCard(Ace, Spade), NewCard(Ace, Spade),
+			NewCard(Ace, Heart), NewCard(Ace, Diamond), NewCard(Ace, Club))
+		Expect(fiveAces.Rank().Category()).To(Equal(FiveOfAKind))
+		Expect(fiveAces.IsLessThan(aceToFive("Kc Kd Kh Ks Qc"))).To(BeTrue())
+	})
+
+	It("sorts with the best low hand last", func() {
+		hands := HandGroup{aceToFive("Ac 2d 3h 4s 5c"), aceToFive("Ac Ad 2h 3s 4c"), aceToFive("8c 6d 4h 3s 2c")}
+		sort.Sort(hands)
+		Expect(hands[2].Rank().Primary()).To(Equal([]Rank{Five}))
+		Expect(hands[0].Rank().Category()).To(Equal(Pair))
+	})
+
+	It("finds the best low hand of seven cards", func() {
+		hand, err := mustParse("Kc Kd 7h 5s 3c 2d Ac").BestAceToFiveHand()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Seven}))
+		Expect(hand.Rank().Kickers()).To(Equal([]Rank{Five, Three, Two, Ace}))
+
+		_, err = mustParse("Kc Kd 7h").BestAceToFiveHand()
+		Expect(errors.Is(err, ErrNotEnoughCards)).To(BeTrue())
+	})
+
+	It("qualifies eight-or-better hands", func() {
+		Expect(aceToFive("8c 6d 4h 3s Ac").QualifiesForLow(EightOrBetter)).To(BeTrue())
+		Expect(aceToFive("9c 6d 4h 3s Ac").QualifiesForLow(EightOrBetter)).To(BeFalse())
+		Expect(aceToFive("7c 7d 4h 3s Ac").QualifiesForLow(EightOrBetter)).To(BeFalse())
+	})
+
+	It("describes low hands", func() {
+		Expect(aceToFive("7c 5d 4h 3s 2c").Describe()).To(Equal("Seven-Five Low"))
+		Expect(aceToFive("Ac 2d 3h 4s 5c").Describe()).To(Equal("Five-Four Low"))
+		Expect(aceToFive("Ac Ad 2h 3s 4c").Describe()).To(Equal("Pair of Aces"))
+	})
+})
+
+func deuceToSeven(s string) *Hand {
+	c := mustParse(s)
+	return NewDeuceToSevenHand(c[0], c[1], c[2], c[3], c[4])
+}
+
+var _ = Describe("Deuce-to-seven lowball", func() {
+	It("ranks seven-five-four-three-two offsuit as the best hand", func() {
+		best := deuceToSeven("7c 5d 4h 3s 2c")
+		Expect(deuceToSeven("7c 6d 4h 3s 2c").IsLessThan(best)).To(BeTrue())
+		Expect(deuceToSeven("8c 5d 4h 3s 2c").IsLessThan(best)).To(BeTrue())
+		Expect(best.Describe()).To(Equal("Seven-Five Low"))
+	})
+
+	It("counts straights and flushes against the hand", func() {
+		Expect(deuceToSeven("6c 5d 4h 3s 2c").IsLessThan(deuceToSeven("Kc Qd Jh Ts 8c"))).To(BeTrue())
+		Expect(deuceToSeven("7h 5h 4h 3h 2h").IsLessThan(deuceToSeven("Kc Qd Jh Ts 8c"))).To(BeTrue())
+		Expect(deuceToSeven("2c 2d 4h 3s 5c").IsLessThan(deuceToSeven("Ac Kd Qh Js 9c"))).To(BeTrue())
+	})
+
+	It("ranks five of a kind from several decks worst of all", func() {
+		fiveAces := NewDeuceToSevenHand(NewCard(Ace, Spade), NewCard(Ace, Spade),
+			NewCard(Ace, Heart), NewCard(Ace, Diamond), NewCard(Ace, Club))
+		Expect(fiveAces.Rank().Category()).To(Equal(FiveOfAKind))
+		Expect(fiveAces.IsLessThan(deuceToSeven("7c 5d 4h 3s 2c"))).To(BeTrue())
+		Expect(fiveAces.IsLessThan(deuceToSeven("As Ks Qs Js Ts"))).To(BeTrue())
+	})
+
+	It("always plays aces high", func() {
+		wheel := deuceToSeven("Ac 2d 3h 4s 5c")
+		Expect(wheel.Rank().Category()).To(Equal(HighCard))
+		Expect(wheel.Rank().Primary()).To(Equal([]Rank{Ace}))
+		Expect(wheel.IsLessThan(deuceToSeven("Kc Qd Jh Ts 8c"))).To(BeTrue())
+		Expect(deuceToSeven("Ah 2h 3h 4h 5h").Rank().Category()).To(Equal(Flush))
+	})
+
+	It("decides a showdown between low hands", func() {
+		alice, bob, carol := NewPlayer("Alice"), NewPlayer("Bob"), NewPlayer("Carol")
+		alice.GetHand(deuceToSeven("8c 6d 4h 3s 2c"))
+		bob.GetHand(deuceToSeven("7c 5d 4d 3c 2d"))
+		carol.GetHand(deuceToSeven("9c 8d 7h 6s 5c"))
+
+		tiers := MustWinnerTiers([]*Player{alice, bob, carol})
+		Expect(tiers).To(Equal([][]*Player{{bob}, {alice}, {carol}}))
+	})
+
+	It("finds the best low hand of several cards", func() {
+		hand, err := mustParse("Ac 2d 3h 4s 5c 7d").BestDeuceToSevenHand()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Seven}))
+		Expect(hand.Rank().Kickers()).To(Equal([]Rank{Five, Four, Three, Two}))
+	})
+})
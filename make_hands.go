@@ -1,27 +1,68 @@
 package goker
 
-import "sort"
+import (
+	"fmt"
+	"sort"
+)
 
 // PossibleHands - Returns all possible 5 card hands that
-// can be created with the cards in this set
+// can be created with the cards in this set. Those holding
+// a joker are unrankable; see Hand.Rank.
 func (c CardSet) PossibleHands() HandGroup {
-	if len(c) < 5 {
+	return c.possibleHands(nil)
+}
+
+func (c CardSet) possibleHands(e Evaluator) HandGroup {
+	combos, err := combinations(5, c)
+	if err != nil {
 		return HandGroup{}
 	}
 
-	combos := combinations(5, c)
 	hands := HandGroup{}
 	for _, combo := range combos {
-		hands = append(hands, NewHandFromSet(combo))
+		hands = append(hands, newHand(e, combo))
 	}
 
 	return hands
 }
 
 // BestPossibleHand - Returns the best possible 5 card
-// hand that can be created with the cards in this set
-func (c CardSet) BestPossibleHand() *Hand {
-	ph := c.PossibleHands()
+// hand that can be created with the cards in this set,
+// or ErrNotEnoughCards if there are fewer than 5 cards,
+// or ErrUnexpectedJoker if any of them is a joker
+func (c CardSet) BestPossibleHand() (*Hand, error) {
+	return c.bestHand(nil)
+}
+
+// BestPossibleHandWith is like BestPossibleHand, but ranks hands with the
+// given evaluator. It is the same as e.BestHand(c, CardSet{}), except for
+// OmahaRules, which can't tell the hole cards from the board in a single
+// set and so give ErrNeedsHoleCards; use their BestHand instead.
+func (c CardSet) BestPossibleHandWith(e Evaluator) (*Hand, error) {
+	if _, ok := e.(OmahaRules); ok {
+		return nil, ErrNeedsHoleCards
+	}
+	return e.BestHand(c, CardSet{})
+}
+
+func (c CardSet) bestHand(e Evaluator) (*Hand, error) {
+	if c.hasJoker() && !allowsJokers(e) {
+		return nil, ErrUnexpectedJoker
+	}
+	ph := c.possibleHands(e)
+	if len(ph) == 0 {
+		return nil, fmt.Errorf("%w: a hand needs 5 cards, have %d", ErrNotEnoughCards, len(c))
+	}
 	sort.Sort(ph)
-	return ph[len(ph)-1]
+	return ph[len(ph)-1], nil
+}
+
+// MustBestPossibleHand is like BestPossibleHand but panics
+// if there are fewer than 5 cards or any of them is a joker
+func (c CardSet) MustBestPossibleHand() *Hand {
+	h, err := c.BestPossibleHand()
+	if err != nil {
+		panic(err)
+	}
+	return h
 }
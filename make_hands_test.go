@@ -1,6 +1,8 @@
 package goker_test
 
 import (
+	"errors"
+
 	. "github.com/sozorogami/goker"
 
 	. "github.com/onsi/ginkgo"
@@ -13,6 +15,12 @@ var _ = Describe("Making hands from sets of cards", func() {
 			cards := CardSet{NewCard(Ace, Diamond), NewCard(King, Spade)}
 			Expect(cards.PossibleHands()).To(BeEmpty())
 		})
+		It("can't find a best hand", func() {
+			cards := CardSet{NewCard(Ace, Diamond), NewCard(King, Spade)}
+			_, err := cards.BestPossibleHand()
+			Expect(errors.Is(err, ErrNotEnoughCards)).To(BeTrue())
+			Expect(func() { cards.MustBestPossibleHand() }).To(Panic())
+		})
 	})
 	Context("when there are five cards in the set", func() {
 		cards := CardSet{
@@ -25,7 +33,7 @@ var _ = Describe("Making hands from sets of cards", func() {
 			Expect(len(cards.PossibleHands())).To(Equal(1))
 		})
 		It("finds the best possible hand", func() {
-			Expect(cards.BestPossibleHand().Rank().Name()).To(Equal("Straight"))
+			Expect(cards.MustBestPossibleHand().Rank().Name()).To(Equal("Straight"))
 		})
 	})
 	Context("when there are seven cards in the set", func() {
@@ -41,7 +49,34 @@ var _ = Describe("Making hands from sets of cards", func() {
 			Expect(len(cards.PossibleHands())).To(Equal(21))
 		})
 		It("finds the best possible hand", func() {
-			Expect(cards.BestPossibleHand().Rank().Name()).To(Equal("RoyalStraightFlush"))
+			Expect(cards.MustBestPossibleHand().Rank().Name()).To(Equal("RoyalStraightFlush"))
+		})
+	})
+	Context("when there is a joker in the set", func() {
+		cards := CardSet{
+			NewCard(Ace, Diamond),
+			NewCard(King, Spade),
+			NewCard(Queen, Spade),
+			NewCard(Jack, Heart),
+			NewCard(Ten, Spade),
+			NewJoker(Red)}
+		It("can't make hands by the usual rules", func() {
+			unrankable := 0
+			for _, h := range cards.PossibleHands() {
+				if h.Rank().Name() == "Unrankable" {
+					unrankable++
+				}
+			}
+			Expect(unrankable).To(Equal(5))
+			_, err := cards.BestPossibleHand()
+			Expect(err).To(MatchError(ErrUnexpectedJoker))
+			_, err = cards.BestAceToFiveHand()
+			Expect(err).To(MatchError(ErrUnexpectedJoker))
+		})
+		It("finds the best hand with wild cards", func() {
+			h, err := cards.BestPossibleHandWith(JokersWild)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.Rank().Category()).To(Equal(Straight))
 		})
 	})
 })
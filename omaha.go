@@ -0,0 +1,80 @@
+package goker
+
+import "fmt"
+
+// OmahaRules rank hands like another evaluator, but only allow hands made
+// of exactly two hole cards and exactly three cards from the board. They
+// work for 4, 5 and 6 card Omaha, and the board must hold from 3 to 5
+// cards.
+type OmahaRules struct {
+	// Ranking ranks the hands, by high hand rules if nil
+	Ranking Evaluator
+}
+
+var (
+	// Omaha ranks high hands by the rules of Omaha
+	Omaha = OmahaRules{High}
+
+	// OmahaLow ranks ace-to-five low hands by the rules of Omaha, for the
+	// low half of Omaha Hi-Lo
+	OmahaLow = OmahaRules{AceToFive}
+)
+
+// BestOmahaHand returns the best 5 card hand that can be made by the rules
+// of Omaha, using exactly two of the hole cards and exactly three cards
+// from the board. It works for 4, 5 and 6 card Omaha, and the board must
+// hold from 3 to 5 cards. The hand can be given to a player with GetHand
+// and settled with WinnerTiers or Showdown like any other.
+func BestOmahaHand(hole CardSet, board CardSet) (*Hand, error) {
+	return Omaha.BestHand(hole, board)
+}
+
+// Rank returns the value of the hand by the rules' Ranking
+func (o OmahaRules) Rank(h Hand) HandRank {
+	return o.ranking().Rank(h)
+}
+
+// BestHand returns the best hand that can be made with exactly two of the
+// hole cards and three cards from the board
+func (o OmahaRules) BestHand(hole CardSet, board CardSet) (*Hand, error) {
+	if len(hole) < 4 || len(hole) > 6 {
+		return nil, fmt.Errorf("%w: Omaha needs 4 to 6 hole cards, have %d", ErrInvalidHoleCards, len(hole))
+	}
+	if len(board) < 3 || len(board) > 5 {
+		return nil, fmt.Errorf("%w: Omaha needs 3 to 5 board cards, have %d", ErrInvalidBoard, len(board))
+	}
+	if !allowsJokers(o.ranking()) {
+		if hole.hasJoker() {
+			return nil, fmt.Errorf("%w: a joker needs wild card rules", ErrInvalidHoleCards)
+		}
+		if board.hasJoker() {
+			return nil, fmt.Errorf("%w: a joker needs wild card rules", ErrInvalidBoard)
+		}
+	}
+	naturals := hole.union(board).withoutJokers()
+	if naturals.Mask().Count() != len(naturals) {
+		return nil, fmt.Errorf("%w among hole cards and board", ErrDuplicateCard)
+	}
+
+	fromHole, _ := combinations(2, hole)
+	fromBoard, _ := combinations(3, board)
+
+	var best *Hand
+	var top []int
+	for _, h := range fromHole {
+		for _, b := range fromBoard {
+			hand := newHand(o, h.union(b))
+			if v := hand.Rank().Value(); best == nil || compareValues(v, top) > 0 {
+				best, top = hand, v
+			}
+		}
+	}
+	return best, nil
+}
+
+func (o OmahaRules) ranking() Evaluator {
+	if o.Ranking == nil {
+		return High
+	}
+	return o.Ranking
+}
@@ -0,0 +1,77 @@
+package goker_test
+
+import (
+	"errors"
+
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("The best Omaha hand", func() {
+	It("uses exactly two hole cards", func() {
+		// Four hearts in hand don't make a flush with one on the board
+		hand, err := BestOmahaHand(mustParse("Ah Kh Qh Jh"), mustParse("Th 2c 3d 7s 8s"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Category()).To(Equal(HighCard))
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Ace}))
+	})
+
+	It("uses exactly three board cards", func() {
+		// Four to a straight on the board plays only with two hole cards
+		hand, err := BestOmahaHand(mustParse("9c 2d 2h 3s"), mustParse("Ts Jd Qh Kc 4d"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Category()).To(Equal(Pair))
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Two}))
+	})
+
+	It("finds the best combination for five and six card Omaha", func() {
+		hand, err := BestOmahaHand(mustParse("As Ad Kh Kd 7c"), mustParse("Ac 7h 7d"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Category()).To(Equal(FullHouse))
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Ace, Seven}))
+
+		hand, err = BestOmahaHand(mustParse("2h 3h 9c 9d Js 4c"), mustParse("4h 5h 6h Tc"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Category()).To(Equal(StraightFlush))
+	})
+
+	It("settles a showdown between Omaha hands", func() {
+		board := mustParse("Th 2c 3d 7s 8s")
+		alice, bob := NewPlayer("Alice"), NewPlayer("Bob")
+		aliceHand, _ := BestOmahaHand(mustParse("Ah Kh Qh Jh"), board)
+		bobHand, _ := BestOmahaHand(mustParse("9d 6c 4c 4d"), board)
+		alice.GetHand(aliceHand)
+		bob.GetHand(bobHand)
+
+		tiers := MustWinnerTiers([]*Player{alice, bob})
+		Expect(tiers[0]).To(Equal([]*Player{bob}))
+	})
+
+	It("rejects the wrong number of cards", func() {
+		_, err := BestOmahaHand(mustParse("Ah Kh"), mustParse("Th 2c 3d"))
+		Expect(errors.Is(err, ErrInvalidHoleCards)).To(BeTrue())
+
+		_, err = BestOmahaHand(mustParse("Ah Kh Qh Jh"), mustParse("Th 2c"))
+		Expect(errors.Is(err, ErrInvalidBoard)).To(BeTrue())
+	})
+
+	It("rejects duplicate cards", func() {
+		_, err := BestOmahaHand(mustParse("Ah Kh Qh Jh"), mustParse("Ah 2c 3d"))
+		Expect(errors.Is(err, ErrDuplicateCard)).To(BeTrue())
+	})
+
+	It("rejects jokers unless they are wild", func() {
+		hole := CardSet{NewJoker(Red), NewCard(Ace, Heart), NewCard(King, Heart), NewCard(Queen, Heart)}
+		_, err := BestOmahaHand(hole, mustParse("Th 2c 3d"))
+		Expect(errors.Is(err, ErrInvalidHoleCards)).To(BeTrue())
+
+		_, err = BestOmahaHand(mustParse("Ah Kh Qh Jh"), CardSet{NewJoker(Red), NewCard(Two, Heart), NewCard(Three, Diamond)})
+		Expect(errors.Is(err, ErrInvalidBoard)).To(BeTrue())
+
+		h, err := OmahaRules{JokersWild}.BestHand(hole, mustParse("Kc 2c 3d"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.Rank().Category()).To(Equal(ThreeOfAKind))
+	})
+})
@@ -0,0 +1,143 @@
+package goker
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseCard parses a single card in standard notation, a rank followed by
+// a suit, e.g. "As", "Td", "10h" or "Q♣". A joker is spelled "Joker", as
+// its String() gives, and parses as the black joker since the spelling
+// doesn't tell its color.
+func ParseCard(s string) (*Card, error) {
+	card, rest, err := parseCard(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("invalid card %q: unexpected trailing %q", s, rest)
+	}
+	return card, nil
+}
+
+// ParseCardSet parses any number of cards in standard notation. Cards may
+// be run together or separated by spaces or commas, e.g. "AsKd Th" or
+// "A♠, K♦, T♥". It is an error for the same card to appear twice.
+func ParseCardSet(s string) (CardSet, error) {
+	cards := CardSet{}
+	seen := make(map[Card]struct{})
+
+	rest := trimSeparators(s)
+	for rest != "" {
+		card, remaining, err := parseCard(rest)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := seen[*card]; exists {
+			return nil, fmt.Errorf("%w %s in %q", ErrDuplicateCard, card, s)
+		}
+		seen[*card] = struct{}{}
+		cards = append(cards, card)
+		rest = trimSeparators(remaining)
+	}
+
+	return cards, nil
+}
+
+// ParseHand parses exactly five distinct cards in standard notation
+// into a hand, e.g. "AsKsQsJsTs"
+func ParseHand(s string) (*Hand, error) {
+	cards, err := ParseCardSet(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(cards) != 5 {
+		return nil, fmt.Errorf("a hand must have 5 cards, found %d in %q", len(cards), s)
+	}
+	return NewHandFromSet(cards), nil
+}
+
+// Parses the card at the start of s, returning it along with the
+// unparsed remainder of the string
+func parseCard(s string) (*Card, string, error) {
+	if s == "" {
+		return nil, "", fmt.Errorf("invalid card: empty string")
+	}
+
+	if len(s) >= len(jokerSpelling) && strings.EqualFold(s[:len(jokerSpelling)], jokerSpelling) {
+		return NewJoker(Black), s[len(jokerSpelling):], nil
+	}
+
+	r, rest, ok := parseRank(s)
+	if !ok {
+		return nil, "", fmt.Errorf("invalid card %q: unknown rank", s)
+	}
+
+	if rest == "" {
+		return nil, "", fmt.Errorf("invalid card %q: missing suit", s)
+	}
+	st, rest, ok := parseSuit(rest)
+	if !ok {
+		return nil, "", fmt.Errorf("invalid card %q: unknown suit", s)
+	}
+
+	return NewCard(r, st), rest, nil
+}
+
+func parseRank(s string) (Rank, string, bool) {
+	if strings.HasPrefix(s, "10") {
+		return Ten, s[2:], true
+	}
+
+	switch unicode.ToUpper(rune(s[0])) {
+	case 'A':
+		return Ace, s[1:], true
+	case 'K':
+		return King, s[1:], true
+	case 'Q':
+		return Queen, s[1:], true
+	case 'J':
+		return Jack, s[1:], true
+	case 'T':
+		return Ten, s[1:], true
+	}
+
+	if s[0] >= '2' && s[0] <= '9' {
+		return Rank(s[0] - '0'), s[1:], true
+	}
+
+	return 0, s, false
+}
+
+func parseSuit(s string) (Suit, string, bool) {
+	r, size := utf8.DecodeRuneInString(s)
+	rest := strings.TrimLeft(s[size:], variationSelectors)
+
+	switch r {
+	case 's', 'S', '♠', '♤':
+		return Spade, rest, true
+	case 'h', 'H', '♥', '♡':
+		return Heart, rest, true
+	case 'd', 'D', '♦', '♢':
+		return Diamond, rest, true
+	case 'c', 'C', '♣', '♧':
+		return Club, rest, true
+	default:
+		return 0, s, false
+	}
+}
+
+// How a joker is written in place of a rank and suit
+const jokerSpelling = "Joker"
+
+// Suit glyphs are often followed by a variation selector choosing
+// text or emoji presentation, e.g. "♠︎"
+const variationSelectors = "\uFE0E\uFE0F"
+
+func trimSeparators(s string) string {
+	return strings.TrimLeftFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+}
@@ -0,0 +1,81 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parsing cards from strings", func() {
+	Describe("a single card", func() {
+		It("understands ASCII notation", func() {
+			Expect(ParseCard("As")).To(Equal(NewCard(Ace, Spade)))
+			Expect(ParseCard("kh")).To(Equal(NewCard(King, Heart)))
+			Expect(ParseCard("Td")).To(Equal(NewCard(Ten, Diamond)))
+			Expect(ParseCard("10d")).To(Equal(NewCard(Ten, Diamond)))
+			Expect(ParseCard("2C")).To(Equal(NewCard(Two, Club)))
+		})
+		It("understands suit glyphs", func() {
+			Expect(ParseCard("Q♣")).To(Equal(NewCard(Queen, Club)))
+			Expect(ParseCard("9♠︎")).To(Equal(NewCard(Nine, Spade)))
+		})
+		It("round trips with String()", func() {
+			cards, _ := NewDeck().Draw(52)
+			for _, card := range cards {
+				Expect(ParseCard(card.String())).To(Equal(card))
+			}
+		})
+		It("round trips a joker with String()", func() {
+			Expect(ParseCard(NewJoker(Black).String())).To(Equal(NewJoker(Black)))
+			Expect(ParseCard("joker")).To(Equal(NewJoker(Black)))
+			Expect(ParseCardSet("As Joker Kd")).To(Equal(CardSet{NewCard(Ace, Spade), NewJoker(Black), NewCard(King, Diamond)}))
+		})
+		It("rejects malformed cards", func() {
+			for _, s := range []string{"", "A", "1s", "Xs", "Ax", "AsK", "As Kd"} {
+				_, err := ParseCard(s)
+				Expect(err).To(HaveOccurred(), s)
+			}
+		})
+		It("says what is wrong with a malformed card", func() {
+			_, err := ParseCard("Xs")
+			Expect(err).To(MatchError(ContainSubstring("unknown rank")))
+			_, err = ParseCard("A")
+			Expect(err).To(MatchError(ContainSubstring("missing suit")))
+			_, err = ParseCard("Ax")
+			Expect(err).To(MatchError(ContainSubstring("unknown suit")))
+		})
+	})
+
+	Describe("a set of cards", func() {
+		It("accepts cards run together or separated", func() {
+			cards, err := ParseCardSet("AsKd Th,9♣ 8h")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cards).To(Equal(CardSet{
+				NewCard(Ace, Spade),
+				NewCard(King, Diamond),
+				NewCard(Ten, Heart),
+				NewCard(Nine, Club),
+				NewCard(Eight, Heart)}))
+		})
+		It("accepts an empty set", func() {
+			Expect(ParseCardSet(" ")).To(BeEmpty())
+		})
+		It("rejects duplicate cards", func() {
+			_, err := ParseCardSet("As Kd A♠")
+			Expect(err).To(MatchError(ContainSubstring("duplicate")))
+		})
+	})
+
+	Describe("a hand", func() {
+		It("makes a sorted hand from five cards", func() {
+			hand, err := ParseHand("As Ks Qs Js Ts")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hand.Rank().Name()).To(Equal("RoyalStraightFlush"))
+		})
+		It("requires exactly five cards", func() {
+			_, err := ParseHand("As Ks Qs Js")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -2,8 +2,9 @@ package goker
 
 // Player represents a poker game participant
 type Player struct {
-	Name string
-	hand *Hand
+	Name    string
+	hand    *Hand
+	lowHand *Hand
 }
 
 // GetHand assigns ownership of the provided hand to the receiver
@@ -12,12 +13,23 @@ func (p *Player) GetHand(h *Hand) {
 	h.owner = p
 }
 
-// MuckHand unassigns ownership of the player's hand
+// GetLowHand assigns ownership of the provided hand to the receiver as
+// their hand for the low half of a split pot. The player keeps it until
+// MuckHand, so call MuckHand before dealing the next hand.
+func (p *Player) GetLowHand(h *Hand) {
+	p.lowHand = h
+	h.owner = p
+}
+
+// MuckHand unassigns ownership of the player's hands
 func (p *Player) MuckHand() {
 	if p.hand != nil {
 		p.hand.owner = nil
 	}
-	p.hand = nil
+	if p.lowHand != nil {
+		p.lowHand.owner = nil
+	}
+	p.hand, p.lowHand = nil, nil
 }
 
 func (p Player) String() string {
@@ -26,6 +38,6 @@ func (p Player) String() string {
 
 // NewPlayer constructs a player with a name and no hand
 func NewPlayer(name string) *Player {
-	p := Player{name, nil}
+	p := Player{Name: name}
 	return &p
 }
@@ -1,5 +1,7 @@
 package goker
 
+import "sort"
+
 // Pot represents an amount of chips with metadata about which players are
 // allowed to win them
 type Pot struct {
@@ -16,3 +18,115 @@ func NewPot(value int, potentialWinners []*Player) *Pot {
 	pot := Pot{value, potentialWinnersSet}
 	return &pot
 }
+
+// BuildPots divides the chips each player has put in over a hand into a
+// main pot and any side pots, main pot first, ready for Showdown. Each pot
+// can be won by the players who put in at least as much as it needs from
+// each player, except those who folded, whose chips still go in the pots.
+// Pots that could be won by the same players are merged. Any part of the
+// largest contribution that nobody matched is an uncalled bet, and is
+// returned to the player who made it rather than put in a pot.
+func BuildPots(contributions map[*Player]int, folded map[*Player]struct{}) ([]*Pot, map[*Player]int) {
+	remaining := make(map[*Player]int)
+	for player, amount := range contributions {
+		if amount > 0 {
+			remaining[player] = amount
+		}
+	}
+
+	refunds := make(map[*Player]int)
+	var top *Player
+	highest, second := 0, 0
+	for player, amount := range remaining {
+		if amount > highest {
+			top, highest, second = player, amount, highest
+		} else if amount > second {
+			second = amount
+		}
+	}
+	if highest > second {
+		refunds[top] = highest - second
+		remaining[top] = second
+	}
+
+	levels := []int{}
+	seen := make(map[int]bool)
+	for _, amount := range remaining {
+		if amount > 0 && !seen[amount] {
+			seen[amount] = true
+			levels = append(levels, amount)
+		}
+	}
+	sort.Ints(levels)
+
+	// Each level takes from every player up to that amount beyond what the
+	// previous levels took
+	pots := []*Pot{}
+	previous := 0
+	for _, level := range levels {
+		value := 0
+		eligible := []*Player{}
+		for player, amount := range remaining {
+			switch {
+			case amount >= level:
+				value += level - previous
+			case amount > previous:
+				value += amount - previous
+			}
+			if _, isFolded := folded[player]; amount >= level && !isFolded {
+				eligible = append(eligible, player)
+			}
+		}
+		previous = level
+
+		// Chips only folded players put in this far go to the last pot
+		// anyone can win
+		if len(pots) > 0 && (len(eligible) == 0 || pots[len(pots)-1].eligibleAre(eligible)) {
+			pots[len(pots)-1].Value += value
+			continue
+		}
+		pots = append(pots, NewPot(value, eligible))
+	}
+
+	return pots, refunds
+}
+
+// True if the players are exactly the pot's potential winners
+func (p Pot) eligibleAre(players []*Player) bool {
+	if len(players) != len(p.PotentialWinners) {
+		return false
+	}
+	for _, player := range players {
+		if _, exists := p.PotentialWinners[player]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// True if at least one of the players is a potential winner of the pot
+func (p Pot) claimableBy(players []*Player) bool {
+	for _, player := range players {
+		if _, exists := p.PotentialWinners[player]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// The players of the best tier with any potential winners of the pot who
+// are in it, or none if no tier has any
+func (p Pot) bestTier(tiers [][]*Player) []*Player {
+	for _, tier := range tiers {
+		winners := []*Player{}
+		for _, player := range tier {
+			if _, exists := p.PotentialWinners[player]; exists {
+				winners = append(winners, player)
+			}
+		}
+		if len(winners) > 0 {
+			return winners
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Building pots", func() {
+	charlie := NewPlayer("Charlie")
+	dennis := NewPlayer("Dennis")
+	dee := NewPlayer("Dee")
+	mac := NewPlayer("Mac")
+	nobodyFolded := map[*Player]struct{}{}
+
+	It("makes a single pot when everyone put in the same", func() {
+		pots, refunds := BuildPots(map[*Player]int{charlie: 100, dennis: 100, dee: 100}, nobodyFolded)
+		Expect(pots).To(Equal([]*Pot{NewPot(300, []*Player{charlie, dennis, dee})}))
+		Expect(refunds).To(BeEmpty())
+	})
+
+	It("makes side pots for players who are all in for less, main pot first", func() {
+		pots, refunds := BuildPots(map[*Player]int{charlie: 25, dennis: 100, dee: 60, mac: 100}, nobodyFolded)
+		Expect(pots).To(Equal([]*Pot{
+			NewPot(100, []*Player{charlie, dennis, dee, mac}),
+			NewPot(105, []*Player{dennis, dee, mac}),
+			NewPot(80, []*Player{dennis, mac}),
+		}))
+		Expect(refunds).To(BeEmpty())
+	})
+
+	It("returns an uncalled bet to the bettor", func() {
+		pots, refunds := BuildPots(map[*Player]int{charlie: 50, dennis: 200, dee: 80}, nobodyFolded)
+		Expect(pots).To(Equal([]*Pot{
+			NewPot(150, []*Player{charlie, dennis, dee}),
+			NewPot(60, []*Player{dennis, dee}),
+		}))
+		Expect(refunds).To(Equal(map[*Player]int{dennis: 120}))
+	})
+
+	It("puts folded players' chips in the pots without letting them win", func() {
+		folded := map[*Player]struct{}{dee: {}}
+		pots, _ := BuildPots(map[*Player]int{charlie: 50, dennis: 100, dee: 70, mac: 100}, folded)
+		Expect(pots).To(Equal([]*Pot{
+			NewPot(200, []*Player{charlie, dennis, mac}),
+			NewPot(120, []*Player{dennis, mac}),
+		}))
+	})
+
+	It("gives chips only folded players put in to the last pot anyone can win", func() {
+		folded := map[*Player]struct{}{dennis: {}, dee: {}}
+		pots, refunds := BuildPots(map[*Player]int{charlie: 40, dennis: 100, dee: 100}, folded)
+		Expect(pots).To(Equal([]*Pot{NewPot(240, []*Player{charlie})}))
+		Expect(refunds).To(BeEmpty())
+	})
+
+	It("returns everything when only one player put anything in", func() {
+		pots, refunds := BuildPots(map[*Player]int{charlie: 30, dennis: 0}, nobodyFolded)
+		Expect(pots).To(BeEmpty())
+		Expect(refunds).To(Equal(map[*Player]int{charlie: 30}))
+	})
+
+	It("builds pots that a showdown pays out in full", func() {
+		defer charlie.MuckHand()
+		defer dennis.MuckHand()
+		defer dee.MuckHand()
+		charlie.GetHand(royalStraightFlush)
+		dennis.GetHand(flush)
+		dee.GetHand(pair)
+
+		pots, _ := BuildPots(map[*Player]int{charlie: 20, dennis: 100, dee: 100}, nobodyFolded)
+		payouts, _ := MustShowdown([]*Player{charlie, dennis, dee}, pots)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 60, dennis: 160}))
+	})
+})
@@ -0,0 +1,416 @@
+package goker
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Combo is a specific pair of hole cards, with the higher ranked card
+// first, or the card of the lower suit first in a pair
+type Combo [2]Card
+
+// NewCombo returns the combo of the two cards given, in either order
+func NewCombo(a, b Card) Combo {
+	if b.Rank > a.Rank || (b.Rank == a.Rank && b.Suit < a.Suit) {
+		a, b = b, a
+	}
+	return Combo{a, b}
+}
+
+// CardSet returns the cards in the combo
+func (c Combo) CardSet() CardSet {
+	return CardSet{NewCard(c[0].Rank, c[0].Suit), NewCard(c[1].Rank, c[1].Suit)}
+}
+
+// Mask returns the cards in the combo as a mask
+func (c Combo) Mask() CardMask {
+	return c[0].Pack().Mask() | c[1].Pack().Mask()
+}
+
+// String returns the combo in ASCII notation, e.g. "AsKd"
+func (c Combo) String() string {
+	return string([]byte{c[0].Rank.Char(), c[0].Suit.Char(), c[1].Rank.Char(), c[1].Suit.Char()})
+}
+
+// WeightedCombo is a combo in a range along with how often it is played,
+// from 0 to 1
+type WeightedCombo struct {
+	Combo
+	Weight float64
+}
+
+// Range is a weighted set of hole card combos, such as the hands a player
+// might hold given their actions
+type Range struct {
+	weights map[Combo]float64
+}
+
+// ParseRange parses a range in standard notation, a comma separated list
+// of any of the following:
+//
+//	AA, AKs, AKo, AK    a pair, or suited, offsuit or all combos of two ranks
+//	AhKh                a specific combo
+//	22+, ATs+, KTo+     a pair and all higher pairs, or a high card with
+//	                    the given kicker and all higher kickers
+//	AA-TT, A5s-A2s      pairs or kickers between the two given, inclusive
+//
+// Any of these may be followed by a weight from 0 to 1, e.g. "AKo:0.5",
+// giving the fraction of the time those combos are played. When a combo
+// is included more than once, the last weight given for it applies.
+func ParseRange(s string) (Range, error) {
+	r := Range{make(map[Combo]float64)}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		weight := 1.0
+		if i := strings.Index(item, ":"); i >= 0 {
+			w, err := strconv.ParseFloat(strings.TrimSpace(item[i+1:]), 64)
+			if err != nil || math.IsNaN(w) || w < 0 || w > 1 {
+				return Range{}, fmt.Errorf("invalid weight in range item %q", item)
+			}
+			weight = w
+			item = strings.TrimSpace(item[:i])
+		}
+
+		combos, err := parseRangeItem(item)
+		if err != nil {
+			return Range{}, err
+		}
+		for _, c := range combos {
+			r.weights[c] = weight
+		}
+	}
+
+	for c, w := range r.weights {
+		if w == 0 {
+			delete(r.weights, c)
+		}
+	}
+	return r, nil
+}
+
+// Len returns the number of combos in the range
+func (r Range) Len() int {
+	return len(r.weights)
+}
+
+// Weight returns how often the combo is played in the range, or zero if
+// it is not in the range
+func (r Range) Weight(c Combo) float64 {
+	return r.weights[c]
+}
+
+// Combos returns every combo in the range with its weight, strongest
+// starting hands first
+func (r Range) Combos() []WeightedCombo {
+	combos := make([]WeightedCombo, 0, len(r.weights))
+	for _, c := range allCombos {
+		if w, exists := r.weights[c]; exists {
+			combos = append(combos, WeightedCombo{c, w})
+		}
+	}
+	return combos
+}
+
+// Without returns the range less any combos containing one of the
+// given cards, e.g. because they are on the board or known to be dead.
+// Jokers block nothing, since no combo holds one.
+func (r Range) Without(cards CardSet) Range {
+	blocked := cards.Mask()
+	filtered := Range{make(map[Combo]float64)}
+	for c, w := range r.weights {
+		if c.Mask()&blocked == 0 {
+			filtered.weights[c] = w
+		}
+	}
+	return filtered
+}
+
+// String returns the range in canonical compact notation, which
+// ParseRange parses back into the same range
+func (r Range) String() string {
+	items := []string{}
+	specific := []WeightedCombo{}
+
+	// Weights of each whole class of starting hand in the range, indexed
+	// by high card, kicker and suitedness. Classes that are only partly in
+	// the range, or with combos of differing weights, are listed combo by
+	// combo at the end.
+	var classes [Ace + 1][Ace + 1][2]float64
+	for _, k := range allClasses {
+		combos := k.combos()
+		w := r.weights[combos[0]]
+		for _, c := range combos {
+			if r.weights[c] != w {
+				w = 0
+				break
+			}
+		}
+		if w != 0 {
+			classes[k.high][k.low][k.suitedIndex()] = w
+			continue
+		}
+		for _, c := range combos {
+			if cw, exists := r.weights[c]; exists {
+				specific = append(specific, WeightedCombo{c, cw})
+			}
+		}
+	}
+
+	// Pairs, then each high card's suited and offsuit hands, are written
+	// as runs of equal weight
+	items = append(items, rangeRuns(Ace,
+		func(r Rank) float64 { return classes[r][r][0] },
+		func(r Rank) string { return handClass{r, r, false}.String() })...)
+	for high := Ace; high > Two; high-- {
+		for _, k := range []handClass{{high: high, suited: true}, {high: high}} {
+			items = append(items, rangeRuns(high-1,
+				func(r Rank) float64 { return classes[high][r][k.suitedIndex()] },
+				func(r Rank) string { return handClass{high, r, k.suited}.String() })...)
+		}
+	}
+
+	sort.SliceStable(specific, func(i, j int) bool {
+		return comboOrder[specific[i].Combo] < comboOrder[specific[j].Combo]
+	})
+	for _, c := range specific {
+		items = append(items, withWeight(c.Combo.String(), c.Weight))
+	}
+
+	return strings.Join(items, ", ")
+}
+
+// Finds runs of consecutive ranks from top down with the same non-zero
+// weight, and writes each as a single item, e.g. "TT+", "A5s-A2s" or "KQo"
+func rangeRuns(top Rank, weight func(Rank) float64, name func(Rank) string) []string {
+	items := []string{}
+	for hi := top; hi >= Two; {
+		w := weight(hi)
+		if w == 0 {
+			hi--
+			continue
+		}
+
+		lo := hi
+		for lo > Two && weight(lo-1) == w {
+			lo--
+		}
+
+		var item string
+		switch {
+		case lo == hi:
+			item = name(hi)
+		case hi == top:
+			item = name(lo) + "+"
+		default:
+			item = name(hi) + "-" + name(lo)
+		}
+		items = append(items, withWeight(item, w))
+		hi = lo - 1
+	}
+	return items
+}
+
+func withWeight(item string, w float64) string {
+	if w == 1 {
+		return item
+	}
+	return item + ":" + strconv.FormatFloat(w, 'g', -1, 64)
+}
+
+// A class of starting hand, e.g. AKs, AKo or AA, ignoring specific suits
+type handClass struct {
+	high, low Rank
+	suited    bool
+}
+
+func (k handClass) suitedIndex() int {
+	if k.suited {
+		return 1
+	}
+	return 0
+}
+
+func (k handClass) String() string {
+	s := string([]byte{k.high.Char(), k.low.Char()})
+	if k.high == k.low {
+		return s
+	}
+	if k.suited {
+		return s + "s"
+	}
+	return s + "o"
+}
+
+// Every combo of the class, in the order of allCombos
+func (k handClass) combos() []Combo {
+	combos := []Combo{}
+	for _, s1 := range AllSuits() {
+		for _, s2 := range AllSuits() {
+			if k.high == k.low && s2 <= s1 {
+				continue
+			}
+			if k.high != k.low && (s1 == s2) != k.suited {
+				continue
+			}
+			combos = append(combos, NewCombo(Card{k.high, s1}, Card{k.low, s2}))
+		}
+	}
+	return combos
+}
+
+// Parses a single item of a range without its weight
+func parseRangeItem(item string) ([]Combo, error) {
+	if cards, err := ParseCardSet(item); err == nil && len(cards) == 2 {
+		return []Combo{NewCombo(*cards[0], *cards[1])}, nil
+	}
+
+	var classes []handClass
+	var err error
+	switch {
+	case strings.HasSuffix(item, "+"):
+		classes, err = parsePlusItem(strings.TrimSuffix(item, "+"))
+	case strings.Contains(item, "-"):
+		parts := strings.SplitN(item, "-", 2)
+		classes, err = parseDashItem(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	default:
+		classes, err = parseClasses(item)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid range item %q: %v", item, err)
+	}
+
+	combos := []Combo{}
+	for _, k := range classes {
+		combos = append(combos, k.combos()...)
+	}
+	return combos, nil
+}
+
+// Parses e.g. "22" for all higher pairs, or "KTo" for all higher kickers
+// with the same high card
+func parsePlusItem(s string) ([]handClass, error) {
+	first, err := parseClasses(s)
+	if err != nil {
+		return nil, err
+	}
+
+	classes := []handClass{}
+	for _, k := range first {
+		if k.high == k.low {
+			for r := k.low; r <= Ace; r++ {
+				classes = append(classes, handClass{r, r, false})
+			}
+			continue
+		}
+		for r := k.low; r < k.high; r++ {
+			classes = append(classes, handClass{k.high, r, k.suited})
+		}
+	}
+	return classes, nil
+}
+
+// Parses e.g. "AA" and "TT" for the pairs between, or "A5s" and "A2s"
+// for the kickers between
+func parseDashItem(from, to string) ([]handClass, error) {
+	start, err := parseClasses(from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseClasses(to)
+	if err != nil {
+		return nil, err
+	}
+	if len(start) != len(end) {
+		return nil, fmt.Errorf("ends of a range must both be suited, offsuit or either")
+	}
+
+	classes := []handClass{}
+	for i := range start {
+		a, b := start[i], end[i]
+		isPair := a.high == a.low
+		if isPair != (b.high == b.low) || (!isPair && a.high != b.high) || a.suited != b.suited {
+			return nil, fmt.Errorf("ends of a range must be pairs or share a high card")
+		}
+
+		lo, hi := a.low, b.low
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		for r := lo; r <= hi; r++ {
+			if isPair {
+				classes = append(classes, handClass{r, r, false})
+			} else {
+				classes = append(classes, handClass{a.high, r, a.suited})
+			}
+		}
+	}
+	return classes, nil
+}
+
+// Parses a pair like "TT", or two ranks like "AK" with an optional "s" or
+// "o", which without either stands for both the suited and offsuit class
+func parseClasses(s string) ([]handClass, error) {
+	if len(s) < 2 || len(s) > 3 {
+		return nil, fmt.Errorf("expected two ranks")
+	}
+	r1, _, ok1 := parseRank(s[0:1])
+	r2, _, ok2 := parseRank(s[1:2])
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("unknown rank")
+	}
+	if r2 > r1 {
+		r1, r2 = r2, r1
+	}
+
+	suffix := strings.ToLower(s[2:])
+	if r1 == r2 {
+		if suffix != "" {
+			return nil, fmt.Errorf("pairs can't be suited or offsuit")
+		}
+		return []handClass{{r1, r1, false}}, nil
+	}
+
+	switch suffix {
+	case "s":
+		return []handClass{{r1, r2, true}}, nil
+	case "o":
+		return []handClass{{r1, r2, false}}, nil
+	case "":
+		return []handClass{{r1, r2, true}, {r1, r2, false}}, nil
+	default:
+		return nil, fmt.Errorf("unknown suffix %q", suffix)
+	}
+}
+
+// Every class of starting hand, and every combo, strongest first: pairs
+// from aces down, then by high card, kicker and suitedness
+var (
+	allClasses []handClass
+	allCombos  []Combo
+	comboOrder = make(map[Combo]int)
+)
+
+func init() {
+	for r := Ace; r >= Two; r-- {
+		allClasses = append(allClasses, handClass{r, r, false})
+	}
+	for high := Ace; high > Two; high-- {
+		for low := high - 1; low >= Two; low-- {
+			allClasses = append(allClasses, handClass{high, low, true}, handClass{high, low, false})
+		}
+	}
+
+	for _, k := range allClasses {
+		for _, c := range k.combos() {
+			comboOrder[c] = len(allCombos)
+			allCombos = append(allCombos, c)
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package goker
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// RangeEquityResult reports how one range fares against the others in a
+// range equity calculation
+type RangeEquityResult struct {
+	// Equity is the range's expected share of the pot
+	Equity float64
+	// Combos breaks the range's equity down by combo, in the order of
+	// Range.Combos, leaving out combos blocked by the board
+	Combos []ComboEquity
+}
+
+// ComboEquity is the equity of a single combo in a range
+type ComboEquity struct {
+	Combo
+	// Frequency is how often the range holds the combo, from 0 to 1. It
+	// accounts for the combo's weight and for cards held by other ranges.
+	Frequency float64
+	// Equity is the combo's expected share of the pot when it is held,
+	// or zero if it never is
+	Equity float64
+}
+
+// DefaultRangeEquityIterations is the number of deals RangeEquity samples
+const DefaultRangeEquityIterations = 100000
+
+// RangeEquity calculates each range's share of the pot against the others
+// on the given board, by dealing each range a combo in proportion to the
+// combos' weights, never dealing the same card twice, and completing the
+// board at random. When there are few enough possible deals, every one of
+// them is enumerated instead. Results are in the same order as ranges.
+func RangeEquity(ranges []Range, board CardSet) ([]RangeEquityResult, error) {
+	return RangeEquityWithOptions(ranges, board, EquityOptions{
+		Iterations: DefaultRangeEquityIterations,
+		Seed:       randomSeed(),
+	})
+}
+
+// RangeEquityWithOptions is like RangeEquity, with control over the number
+// of deals sampled, the goroutines used and the random seed. The
+// ExactThreshold option applies to the number of combinations of combos
+// times the number of board runouts.
+func RangeEquityWithOptions(ranges []Range, board CardSet, opts EquityOptions) ([]RangeEquityResult, error) {
+	if len(ranges) < 2 {
+		return nil, ErrNotEnoughPlayers
+	}
+	if len(board) > 5 {
+		return nil, fmt.Errorf("%w: a board has at most 5 cards, found %d", ErrInvalidBoard, len(board))
+	}
+	if board.hasJoker() {
+		return nil, fmt.Errorf("%w: jokers can't be dealt in hold'em", ErrInvalidBoard)
+	}
+	boardMask := board.Mask()
+	if boardMask.Count() != len(board) {
+		return nil, fmt.Errorf("%w on the board", ErrDuplicateCard)
+	}
+	if opts.Iterations <= 0 {
+		return nil, ErrNoIterations
+	}
+
+	combos := make([][]WeightedCombo, len(ranges))
+	deals := 1
+	for p, r := range ranges {
+		combos[p] = r.Without(board).Combos()
+		if len(combos[p]) == 0 {
+			return nil, fmt.Errorf("%w: range %d is empty once the board is removed", ErrNoValidCombos, p+1)
+		}
+		deals = cappedProduct(deals, len(combos[p]))
+	}
+	deals = cappedProduct(deals, binomial(52-len(board)-2*len(ranges), 5-len(board)))
+
+	threshold := opts.ExactThreshold
+	if threshold == 0 {
+		threshold = DefaultExactThreshold
+	}
+	if deals <= threshold {
+		tally := newRangeTally(combos)
+		dealEveryRangeBoard(combos, boardMask, tally)
+		if tally.total == 0 {
+			return nil, ErrNoValidCombos
+		}
+		return tally.results(), nil
+	}
+
+	listed, complete := listComboDeals(combos, boardMask, maxListedDeals)
+	if complete && len(listed.cumulative) == 0 {
+		return nil, ErrNoValidCombos
+	}
+	if !complete {
+		listed = nil
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > opts.Iterations {
+		workers = opts.Iterations
+	}
+
+	tallies := make([]*rangeTally, workers)
+	var wg sync.WaitGroup
+	for w := range tallies {
+		iterations := opts.Iterations / workers
+		if w < opts.Iterations%workers {
+			iterations++
+		}
+
+		wg.Add(1)
+		go func(w, iterations int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(opts.Seed + int64(w)))
+			tallies[w] = newRangeTally(combos)
+			sampleRangeBoards(combos, boardMask, listed, iterations, rng, tallies[w])
+		}(w, iterations)
+	}
+	wg.Wait()
+
+	total := newRangeTally(combos)
+	for _, t := range tallies {
+		total.add(t)
+	}
+	return total.results(), nil
+}
+
+// The most compatible deals of combos to list, so that sampling can pick
+// from them directly. Ranges that can be dealt in more ways than this are
+// sampled by dealing each a combo at random and dealing again if two
+// share a card, which is quick since so many deals are compatible.
+const maxListedDeals = 100000
+
+// Compatible deals of one combo to each range, as the index of each
+// range's combo, one deal after another, with the running total of the
+// deals' weights
+type comboDeals struct {
+	chosen     []int
+	cumulative []float64
+}
+
+// Lists every deal of one combo to each range in which no two share a
+// card, along with true, or returns false if there are more than max
+func listComboDeals(combos [][]WeightedCombo, board CardMask, max int) (*comboDeals, bool) {
+	deals := comboDeals{}
+	chosen := make([]int, len(combos))
+
+	var list func(p int, used CardMask, weight float64) bool
+	list = func(p int, used CardMask, weight float64) bool {
+		if p == len(combos) {
+			n := len(deals.cumulative)
+			if n == max {
+				return false
+			}
+			if n > 0 {
+				weight += deals.cumulative[n-1]
+			}
+			deals.chosen = append(deals.chosen, chosen...)
+			deals.cumulative = append(deals.cumulative, weight)
+			return true
+		}
+		for i, c := range combos[p] {
+			if used&c.Mask() == 0 {
+				chosen[p] = i
+				if !list(p+1, used|c.Mask(), weight*c.Weight) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	complete := list(0, board, 1)
+	return &deals, complete
+}
+
+// Deals each range a combo in proportion to its weight and completes the
+// board at random, repeatedly, recording the results in the tally. Deals
+// are picked from the listed ones if given, and otherwise there must be
+// some compatible deal.
+func sampleRangeBoards(combos [][]WeightedCombo, board CardMask, listed *comboDeals, iterations int, rng *rand.Rand, tally *rangeTally) {
+	cumulative := make([][]float64, len(combos))
+	for p := range combos {
+		sum := 0.0
+		cumulative[p] = make([]float64, len(combos[p]))
+		for i, c := range combos[p] {
+			sum += c.Weight
+			cumulative[p][i] = sum
+		}
+	}
+
+	chosen := make([]int, len(combos))
+	strengths := make([]Strength, len(combos))
+	for i := 0; i < iterations; i++ {
+		if listed != nil {
+			k := pickWeighted(listed.cumulative, rng)
+			copy(chosen, listed.chosen[k*len(combos):])
+		} else {
+			dealCombos(combos, cumulative, board, rng, chosen)
+		}
+		used := board
+		for p := range combos {
+			used |= combos[p][chosen[p]].Mask()
+		}
+
+		// Few enough cards are in use that drawing from the whole deck and
+		// skipping them is quicker than building what remains
+		runout := board
+		for runout.Count() < 5 {
+			p := PackedCard(rng.Intn(52))
+			if !used.Contains(p) {
+				used = used.Add(p)
+				runout = runout.Add(p)
+			}
+		}
+
+		for p := range combos {
+			strengths[p] = combos[p][chosen[p]].Mask().Union(runout).Strength()
+		}
+		tally.record(chosen, strengths, 1)
+	}
+}
+
+// Chooses a combo for each range in proportion to the combos' running
+// total weights, choosing again until no two share a card
+func dealCombos(combos [][]WeightedCombo, cumulative [][]float64, board CardMask, rng *rand.Rand, chosen []int) {
+	for {
+		used := board
+		ok := true
+		for p := range combos {
+			chosen[p] = pickWeighted(cumulative[p], rng)
+			m := combos[p][chosen[p]].Mask()
+			if used&m != 0 {
+				ok = false
+				break
+			}
+			used |= m
+		}
+		if ok {
+			return
+		}
+	}
+}
+
+// Picks an index at random in proportion to the weights whose running
+// totals are given
+func pickWeighted(cumulative []float64, rng *rand.Rand) int {
+	x := rng.Float64() * cumulative[len(cumulative)-1]
+	i := sort.SearchFloat64s(cumulative, x)
+	if i == len(cumulative) {
+		i--
+	}
+	return i
+}
+
+// Deals every compatible combination of combos from the ranges with every
+// completion of the board, recording each weighted by its combos' weights
+func dealEveryRangeBoard(combos [][]WeightedCombo, board CardMask, tally *rangeTally) {
+	chosen := make([]int, len(combos))
+	strengths := make([]Strength, len(combos))
+	undealt := make([]PackedCard, 0, 52)
+	dealWeight := 0.0
+	record := func(full CardMask) {
+		for q := range combos {
+			strengths[q] = combos[q][chosen[q]].Mask().Union(full).Strength()
+		}
+		tally.record(chosen, strengths, dealWeight)
+	}
+
+	var deal func(p int, used CardMask, weight float64)
+	deal = func(p int, used CardMask, weight float64) {
+		if p < len(combos) {
+			for i, c := range combos[p] {
+				if used&c.Mask() == 0 {
+					chosen[p] = i
+					deal(p+1, used|c.Mask(), weight*c.Weight)
+				}
+			}
+			return
+		}
+
+		undealt = FullDeckMask.Remove(used).Cards(undealt[:0])
+		dealWeight = weight
+		forEachRunout(board, undealt, 5-board.Count(), record)
+	}
+	deal(0, board, 1)
+}
+
+// Running totals of each range's and combo's share of the pot, along with
+// the total weight of the deals they took part in
+type rangeTally struct {
+	total       float64
+	shares      []float64
+	comboShares [][]float64
+	comboWeight [][]float64
+	combos      [][]WeightedCombo
+}
+
+func newRangeTally(combos [][]WeightedCombo) *rangeTally {
+	t := rangeTally{
+		shares:      make([]float64, len(combos)),
+		comboShares: make([][]float64, len(combos)),
+		comboWeight: make([][]float64, len(combos)),
+		combos:      combos,
+	}
+	for p := range combos {
+		t.comboShares[p] = make([]float64, len(combos[p]))
+		t.comboWeight[p] = make([]float64, len(combos[p]))
+	}
+	return &t
+}
+
+// Records the result of a deal given the combo dealt to each range, the
+// strength of each range's hand and the weight of the deal
+func (t *rangeTally) record(chosen []int, strengths []Strength, weight float64) {
+	best, winners := bestStrength(strengths)
+	for p, s := range strengths {
+		share := 0.0
+		if s == best {
+			share = weight / float64(winners)
+		}
+		t.shares[p] += share
+		t.comboShares[p][chosen[p]] += share
+		t.comboWeight[p][chosen[p]] += weight
+	}
+	t.total += weight
+}
+
+func (t *rangeTally) add(other *rangeTally) {
+	t.total += other.total
+	for p := range t.shares {
+		t.shares[p] += other.shares[p]
+		for i := range t.comboShares[p] {
+			t.comboShares[p][i] += other.comboShares[p][i]
+			t.comboWeight[p][i] += other.comboWeight[p][i]
+		}
+	}
+}
+
+func (t *rangeTally) results() []RangeEquityResult {
+	results := make([]RangeEquityResult, len(t.shares))
+	for p := range results {
+		results[p].Equity = t.shares[p] / t.total
+		results[p].Combos = make([]ComboEquity, len(t.combos[p]))
+		for i, c := range t.combos[p] {
+			ce := ComboEquity{Combo: c.Combo, Frequency: t.comboWeight[p][i] / t.total}
+			if t.comboWeight[p][i] > 0 {
+				ce.Equity = t.comboShares[p][i] / t.comboWeight[p][i]
+			}
+			results[p].Combos[i] = ce
+		}
+	}
+	return results
+}
+
+// Multiplies two counts, capping the result so it doesn't overflow
+func cappedProduct(a, b int) int {
+	const limit = 1 << 40
+	if b == 0 {
+		return 0
+	}
+	if a > limit/b {
+		return limit
+	}
+	return a * b
+}
@@ -0,0 +1,104 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Calculating range equity", func() {
+	opts := EquityOptions{Iterations: 20000, Workers: 4, Seed: 1}
+
+	It("agrees with hand equity for single combos", func() {
+		turn := mustParse("2c7d9hQc")
+		results, err := RangeEquity([]Range{mustParseRange("AsAh"), mustParseRange("KsKh")}, turn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results[0].Equity).To(BeNumerically("~", 42.0/44, 1e-9))
+		Expect(results[1].Equity).To(BeNumerically("~", 2.0/44, 1e-9))
+		Expect(results[1].Combos).To(Equal([]ComboEquity{{combo("KsKh"), 1, results[1].Equity}}))
+	})
+
+	It("finds aces are a big favourite over kings", func() {
+		results, err := RangeEquityWithOptions([]Range{mustParseRange("AA"), mustParseRange("KK")}, nil, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results[0].Equity).To(BeNumerically("~", 0.82, 0.015))
+		Expect(results[0].Equity + results[1].Equity).To(BeNumerically("~", 1, 1e-9))
+	})
+
+	It("deals combos in proportion to their weights", func() {
+		results, _ := RangeEquityWithOptions([]Range{mustParseRange("AA:0.5, KK"), mustParseRange("QQ")}, nil, opts)
+		aces := 0.0
+		for _, c := range results[0].Combos {
+			if c.Combo[0].Rank == Ace {
+				aces += c.Frequency
+			}
+		}
+		Expect(aces).To(BeNumerically("~", 1.0/3, 0.015))
+	})
+
+	It("never deals cards held by another range", func() {
+		results, _ := RangeEquityWithOptions([]Range{mustParseRange("AsAh"), mustParseRange("AA")}, nil, opts)
+		for _, c := range results[1].Combos {
+			if c.Combo == combo("AdAc") {
+				Expect(c.Frequency).To(Equal(1.0))
+			} else {
+				Expect(c.Frequency).To(BeZero())
+				Expect(c.Equity).To(BeZero())
+			}
+		}
+	})
+
+	It("leaves out combos blocked by the board", func() {
+		results, _ := RangeEquity([]Range{mustParseRange("AA"), mustParseRange("KK")}, mustParse("As2c3d4h5s"))
+		Expect(results[0].Combos).To(HaveLen(3))
+	})
+
+	It("samples ranges that can rarely be dealt together", func() {
+		ranges := []Range{mustParseRange("AsAh, KsKh:0.000001"), mustParseRange("AsAd")}
+		results, err := RangeEquityWithOptions(ranges, nil, EquityOptions{Iterations: 100, Seed: 1, ExactThreshold: -1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results[0].Combos[0].Frequency).To(BeZero())
+		Expect(results[0].Combos[1].Frequency).To(Equal(1.0))
+	})
+
+	It("agrees between enumerating and sampling", func() {
+		ranges := []Range{mustParseRange("AA, KK"), mustParseRange("QQ, AKs")}
+		flop := mustParse("2c7d9h")
+
+		exact, err := RangeEquityWithOptions(ranges, flop, EquityOptions{Iterations: 1, ExactThreshold: 1 << 20})
+		Expect(err).NotTo(HaveOccurred())
+		sampled, err := RangeEquityWithOptions(ranges, flop, EquityOptions{Iterations: 20000, Seed: 1, ExactThreshold: -1})
+		Expect(err).NotTo(HaveOccurred())
+
+		for p := range ranges {
+			Expect(sampled[p].Equity).To(BeNumerically("~", exact[p].Equity, 0.015))
+			for i := range exact[p].Combos {
+				Expect(sampled[p].Combos[i].Frequency).To(BeNumerically("~", exact[p].Combos[i].Frequency, 0.015))
+			}
+		}
+	})
+
+	Context("with invalid ranges", func() {
+		It("needs at least two ranges", func() {
+			_, err := RangeEquity([]Range{mustParseRange("AA")}, nil)
+			Expect(err).To(MatchError(ErrNotEnoughPlayers))
+		})
+		It("needs combos left once the board is removed", func() {
+			_, err := RangeEquity([]Range{mustParseRange("AsAh"), mustParseRange("KK")}, mustParse("As2c3d"))
+			Expect(err).To(MatchError(ContainSubstring("range 1")))
+		})
+		It("needs combos that can be dealt together", func() {
+			_, err := RangeEquityWithOptions([]Range{mustParseRange("AsAh"), mustParseRange("AsAh")}, nil, opts)
+			Expect(err).To(MatchError(ErrNoValidCombos))
+		})
+		It("won't deal jokers", func() {
+			_, err := RangeEquity([]Range{mustParseRange("AA"), mustParseRange("KK")}, CardSet{NewJoker(Red)})
+			Expect(err).To(MatchError(ErrInvalidBoard))
+		})
+		It("needs some iterations", func() {
+			_, err := RangeEquityWithOptions([]Range{mustParseRange("AA"), mustParseRange("KK")}, nil, EquityOptions{ExactThreshold: -1})
+			Expect(err).To(MatchError(ErrNoIterations))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func mustParseRange(s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func combo(s string) Combo {
+	cards := mustParse(s)
+	return NewCombo(*cards[0], *cards[1])
+}
+
+var _ = Describe("Hand ranges", func() {
+	Describe("parsing", func() {
+		It("counts the combos in each kind of starting hand", func() {
+			Expect(mustParseRange("AA").Len()).To(Equal(6))
+			Expect(mustParseRange("AKs").Len()).To(Equal(4))
+			Expect(mustParseRange("AKo").Len()).To(Equal(12))
+			Expect(mustParseRange("AK").Len()).To(Equal(16))
+			Expect(mustParseRange("AhKh").Len()).To(Equal(1))
+		})
+
+		It("expands pluses and dashes", func() {
+			Expect(mustParseRange("22+").Len()).To(Equal(13 * 6))
+			Expect(mustParseRange("AA-TT").Len()).To(Equal(5 * 6))
+			Expect(mustParseRange("TT-AA").Len()).To(Equal(5 * 6))
+			Expect(mustParseRange("KQo+").Len()).To(Equal(12))
+			Expect(mustParseRange("A2s+").Len()).To(Equal(12 * 4))
+			Expect(mustParseRange("A5s-A2s").Len()).To(Equal(4 * 4))
+			Expect(mustParseRange("AT-AJ").Len()).To(Equal(2 * 16))
+		})
+
+		It("combines items and weights", func() {
+			r := mustParseRange("AA-TT, AKs, KQo+, A5s-A2s, 22+, AKo:0.5")
+			Expect(r.Len()).To(Equal(13*6 + 4 + 12 + 16 + 12))
+			Expect(r.Weight(combo("AsKs"))).To(Equal(1.0))
+			Expect(r.Weight(combo("AsKd"))).To(Equal(0.5))
+			Expect(r.Weight(combo("KdAs"))).To(Equal(0.5))
+			Expect(r.Weight(combo("AsQd"))).To(BeZero())
+		})
+
+		It("lets later items override earlier ones", func() {
+			r := mustParseRange("22+, TT:0.25, 22:0")
+			Expect(r.Len()).To(Equal(12 * 6))
+			Expect(r.Weight(combo("ThTd"))).To(Equal(0.25))
+		})
+
+		It("rejects malformed ranges", func() {
+			for _, s := range []string{"AKx", "AAs", "A", "AKs-KQs", "AA-AKs", "AKs:2", "AKs:x", "AKo:NaN", "AKo:Inf", "AKo:-Inf", "AsAs", "XX"} {
+				_, err := ParseRange(s)
+				Expect(err).To(HaveOccurred(), s)
+			}
+		})
+	})
+
+	It("lists its combos strongest first", func() {
+		combos := mustParseRange("AKo:0.5, KK").Combos()
+		Expect(combos).To(HaveLen(18))
+		Expect(combos[0].Combo).To(Equal(combo("KsKh")))
+		Expect(combos[17]).To(Equal(WeightedCombo{combo("AcKd"), 0.5}))
+	})
+
+	It("removes combos blocked by known cards", func() {
+		r := mustParseRange("AA, AKs").Without(mustParse("As"))
+		Expect(r.Len()).To(Equal(3 + 3))
+		Expect(r.Weight(combo("AsAh"))).To(BeZero())
+		Expect(r.Weight(combo("AhAd"))).To(Equal(1.0))
+	})
+
+	It("isn't blocked by jokers", func() {
+		r := mustParseRange("AA").Without(CardSet{NewJoker(Red), NewJoker(Black)})
+		Expect(r.Len()).To(Equal(6))
+	})
+
+	Describe("printing", func() {
+		It("prints runs compactly", func() {
+			Expect(mustParseRange("TT, JJ, QQ, KK, AA").String()).To(Equal("TT+"))
+			Expect(mustParseRange("AA").String()).To(Equal("AA"))
+			Expect(mustParseRange("99-66, 22").String()).To(Equal("99-66, 22"))
+			Expect(mustParseRange("ATs+, A5s-A2s, KQo+").String()).To(Equal("ATs+, A5s-A2s, KQo"))
+			Expect(mustParseRange("AK").String()).To(Equal("AKs, AKo"))
+		})
+
+		It("prints weights", func() {
+			Expect(mustParseRange("QQ+:0.5, JJ, AKo:0.25").String()).To(Equal("QQ+:0.5, JJ, AKo:0.25"))
+		})
+
+		It("prints combos that don't make up a whole class", func() {
+			r := mustParseRange("KK, AKs").Without(mustParse("Ks"))
+			Expect(r.String()).To(Equal("KhKd, KhKc, KdKc, AhKh, AdKd, AcKc"))
+		})
+
+		It("parses back into the same range", func() {
+			for _, s := range []string{
+				"AA-TT, AKs, KQo+, A5s-A2s, 22+, AKo:0.5",
+				"77-44:0.3, A2+, K9s-K6s:0.75, JTo",
+				"AhKh, 7c2d:0.1, QQ",
+			} {
+				r := mustParseRange(s)
+				Expect(mustParseRange(r.String())).To(Equal(r), s)
+			}
+		})
+	})
+})
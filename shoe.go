@@ -0,0 +1,79 @@
+package goker
+
+// Shoe describes the cards of a deck that isn't a single standard deck,
+// such as a shoe of several decks shuffled together, a deck with ranks or
+// suits removed, or any other composition. Duplicate cards are allowed
+// and are ranked like any others, e.g. two identical aces are a pair.
+type Shoe struct {
+	// Decks is the number of decks shuffled together, or 1 if zero
+	Decks int
+
+	// Custom holds the cards of each deck, or a standard 52 card deck if
+	// it is empty
+	Custom CardSet
+
+	// WithoutRanks are removed from every deck, e.g. Two through Five for
+	// a short deck
+	WithoutRanks []Rank
+
+	// WithoutSuits are removed from every deck
+	WithoutSuits []Suit
+
+	// Jokers is the number of jokers added to the shoe, alternately black
+	// and red
+	Jokers int
+}
+
+// Cards returns every card in the shoe, unshuffled
+func (s Shoe) Cards() CardSet {
+	deck := s.Custom
+	if len(deck) == 0 {
+		deck = standardCards()
+	}
+
+	removed := make(map[Card]bool)
+	for _, card := range deck {
+		for _, r := range s.WithoutRanks {
+			removed[*card] = removed[*card] || card.Rank == r
+		}
+		for _, suit := range s.WithoutSuits {
+			removed[*card] = removed[*card] || card.Suit == suit
+		}
+	}
+
+	decks := s.Decks
+	if decks <= 0 {
+		decks = 1
+	}
+
+	cards := CardSet{}
+	for i := 0; i < decks; i++ {
+		for _, card := range deck {
+			if !removed[*card] {
+				cards = append(cards, NewCard(card.Rank, card.Suit))
+			}
+		}
+	}
+	for i := 0; i < s.Jokers; i++ {
+		cards = append(cards, NewJoker(Color(i%2)))
+	}
+	return cards
+}
+
+// NewDeck constructs a deck of the cards in the shoe, shuffled with a
+// randomly chosen seed which is recorded so that the deal can be replayed
+func (s Shoe) NewDeck() *Deck {
+	return s.NewSeededDeck(randomSeed())
+}
+
+// NewSeededDeck constructs a deck of the cards in the shoe, shuffled by a
+// pseudo-random source with the given seed
+func (s Shoe) NewSeededDeck(seed int64) *Deck {
+	return newSeededDeck(s.Cards(), seed)
+}
+
+// NewDeckWithShuffler constructs a deck of the cards in the shoe,
+// shuffled by the given shuffler
+func (s Shoe) NewDeckWithShuffler(sh Shuffler) *Deck {
+	return newDeck(s.Cards(), sh)
+}
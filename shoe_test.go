@@ -0,0 +1,52 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shoes", func() {
+	It("shuffle several decks together", func() {
+		deck := Shoe{Decks: 6}.NewSeededDeck(1)
+		Expect(deck.Len()).To(Equal(312))
+		Expect(deck.Remove(NewCard(Ace, Spade), NewCard(Ace, Spade))).To(Succeed())
+		Expect(deck.Len()).To(Equal(310))
+	})
+
+	It("remove ranks and suits and add jokers", func() {
+		cards := Shoe{Decks: 2, WithoutRanks: []Rank{Two, Three}, WithoutSuits: []Suit{Club}, Jokers: 3}.Cards()
+		Expect(cards).To(HaveLen(2*11*3 + 3))
+		for _, card := range cards {
+			if !card.IsJoker() {
+				Expect(card.Rank).To(BeNumerically(">", Three))
+				Expect(card.Suit).NotTo(Equal(Club))
+			}
+		}
+	})
+
+	It("build custom compositions", func() {
+		shoe := Shoe{Decks: 3, Custom: mustParse("As Ks Qs")}
+		Expect(shoe.Cards()).To(HaveLen(9))
+
+		first, _ := shoe.NewSeededDeck(4).Draw(9)
+		second, _ := shoe.NewSeededDeck(4).Draw(9)
+		Expect(first).To(Equal(second))
+		Expect(shoe.NewDeck().Len()).To(Equal(9))
+	})
+
+	It("rank hands with duplicate cards", func() {
+		ace := NewCard(Ace, Spade)
+		flush := NewHand(ace, ace, NewCard(King, Spade), NewCard(Queen, Spade), NewCard(Nine, Spade))
+		Expect(flush.Rank().Category()).To(Equal(Flush))
+
+		five := NewHand(ace, ace, ace, ace, ace)
+		Expect(five.Rank().Category()).To(Equal(FiveOfAKind))
+		Expect(five.Describe()).To(Equal("Five of a Kind, Aces"))
+
+		cards := CardSet{ace, ace, ace, NewCard(Ace, Heart), NewCard(Ace, Club), NewCard(Two, Club), NewCard(Three, Club)}
+		Expect(cards.Strength()).To(Equal(five.Strength()))
+		Expect(cards.MustBestPossibleHand().Rank().Category()).To(Equal(FiveOfAKind))
+	})
+})
@@ -0,0 +1,104 @@
+package goker
+
+// ShortDeckRules are the rules for ranking hands in short deck (Six-plus)
+// Hold'em, played with the 36 card deck of NewShortDeck. A-6-7-8-9 is a
+// straight, and flushes beat full houses since they are harder to make.
+type ShortDeckRules struct {
+	// TripsBeatStraights ranks three of a kind above straights, as some
+	// games do since straights are easier to make with fewer ranks
+	TripsBeatStraights bool
+}
+
+// ShortDeck are the most common short deck rules, with straights above
+// three of a kind
+var ShortDeck = ShortDeckRules{}
+
+// NewHand returns a pointer to a new hand consisting of the provided
+// cards, ranked by these short deck rules. Hands ranked this way should
+// only be compared with one another.
+func (sd ShortDeckRules) NewHand(card1, card2, card3, card4, card5 *Card) *Hand {
+	return NewHandWith(sd, card1, card2, card3, card4, card5)
+}
+
+// BestHand returns the best 5 card hand by these short deck rules that
+// can be made with the hole cards and board, or ErrNotEnoughCards if
+// there are fewer than 5 cards between them
+func (sd ShortDeckRules) BestHand(hole, board CardSet) (*Hand, error) {
+	return hole.union(board).bestHand(sd)
+}
+
+// Rank returns the value of the hand by these short deck rules
+func (sd ShortDeckRules) Rank(h Hand) HandRank {
+	if h.hasJoker() {
+		return newUnrankable()
+	}
+	rank := h.highRank()
+	if h.isShortDeckAceLowStraight() {
+		if h.isFlush() {
+			rank = newStraightFlush(Nine)
+		} else {
+			rank = newStraight(Nine)
+		}
+	}
+	return newShortDeckRank(rank, sd.TripsBeatStraights)
+}
+
+func (h Hand) isShortDeckAceLowStraight() bool {
+	aceLowStraight := NewHand(
+		NewCard(Ace, Club),
+		NewCard(Six, Heart),
+		NewCard(Seven, Spade),
+		NewCard(Eight, Diamond),
+		NewCard(Nine, Club))
+
+	return h.equalRanks(aceLowStraight)
+}
+
+// Short Deck
+
+type shortDeckRank struct {
+	high               HandRank
+	tripsBeatStraights bool
+}
+
+func newShortDeckRank(high HandRank, tripsBeatStraights bool) *shortDeckRank {
+	sdr := shortDeckRank{high, tripsBeatStraights}
+	return &sdr
+}
+
+// The value of the hand by high hand rules, with the categories that
+// short deck reorders swapped
+func (sdr shortDeckRank) Value() []int {
+	val := append([]int{}, sdr.high.Value()...)
+	switch sdr.Category() {
+	case Flush:
+		val[0] = int(FullHouse)
+	case FullHouse:
+		val[0] = int(Flush)
+	case Straight:
+		if sdr.tripsBeatStraights {
+			val[0] = int(ThreeOfAKind)
+		}
+	case ThreeOfAKind:
+		if sdr.tripsBeatStraights {
+			val[0] = int(Straight)
+		}
+	}
+	return val
+}
+
+func (sdr shortDeckRank) Name() string {
+	return sdr.high.Name()
+}
+
+func (sdr shortDeckRank) Category() Category {
+	return sdr.high.Category()
+}
+
+func (sdr shortDeckRank) Primary() []Rank {
+	return sdr.high.Primary()
+}
+
+func (sdr shortDeckRank) Kickers() []Rank {
+	return sdr.high.Kickers()
+}
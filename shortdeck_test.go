@@ -0,0 +1,60 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func shortDeck(rules ShortDeckRules, s string) *Hand {
+	c := mustParse(s)
+	return rules.NewHand(c[0], c[1], c[2], c[3], c[4])
+}
+
+var _ = Describe("Short deck", func() {
+	It("deals 36 cards without twos through fives", func() {
+		deck := NewShortDeck()
+		Expect(deck.Len()).To(Equal(36))
+		cards, _ := deck.Draw(36)
+		for _, card := range cards {
+			Expect(card.Rank).To(BeNumerically(">=", Six))
+		}
+	})
+
+	It("replays a seeded deal", func() {
+		first, _ := NewSeededShortDeck(7).Draw(10)
+		second, _ := NewSeededShortDeck(7).Draw(10)
+		Expect(first).To(Equal(second))
+	})
+
+	It("counts ace-six-seven-eight-nine as a nine high straight", func() {
+		wheel := shortDeck(ShortDeck, "Ac 6d 7h 8s 9c")
+		Expect(wheel.Rank().Category()).To(Equal(Straight))
+		Expect(wheel.Describe()).To(Equal("Straight, Nine high"))
+		Expect(wheel.IsLessThan(shortDeck(ShortDeck, "6c 7d 8h 9s Tc"))).To(BeTrue())
+		Expect(shortDeck(ShortDeck, "Ah 6h 7h 8h 9h").Rank().Category()).To(Equal(StraightFlush))
+	})
+
+	It("ranks flushes above full houses", func() {
+		Expect(shortDeck(ShortDeck, "Kc Kd Kh 6s 6c").IsLessThan(shortDeck(ShortDeck, "6h 8h 9h Jh Kh"))).To(BeTrue())
+		Expect(shortDeck(ShortDeck, "Kc Kd Kh Ks 6c").IsLessThan(shortDeck(ShortDeck, "6h 8h 9h Jh Kh"))).To(BeFalse())
+	})
+
+	It("optionally ranks trips above straights", func() {
+		rules := ShortDeckRules{TripsBeatStraights: true}
+		Expect(shortDeck(ShortDeck, "6c 6d 6h Ks Qc").IsLessThan(shortDeck(ShortDeck, "6c 7d 8h 9s Tc"))).To(BeTrue())
+		Expect(shortDeck(rules, "6c 7d 8h 9s Tc").IsLessThan(shortDeck(rules, "6c 6d 6h Ks Qc"))).To(BeTrue())
+	})
+
+	It("decides a showdown by short deck rules", func() {
+		board := mustParse("Kh 9h 6h Kd 7c")
+		alice, bob := NewPlayer("Alice"), NewPlayer("Bob")
+		aliceHand, _ := ShortDeck.BestHand(mustParse("Ah 8h"), board)
+		bobHand, _ := ShortDeck.BestHand(mustParse("Ks 9c"), board)
+		alice.GetHand(aliceHand)
+		bob.GetHand(bobHand)
+
+		Expect(MustWinnerTiers([]*Player{alice, bob})).To(Equal([][]*Player{{alice}, {bob}}))
+	})
+})
@@ -1,6 +1,7 @@
 package goker
 
 import (
+	"fmt"
 	"sort"
 )
 
@@ -8,8 +9,31 @@ import (
 // and a slice of all pots in play. It returns the payout in chips for each
 // player when they reveal their hands and face off. It also returns a slice
 // of any odd chips which could not be divided evenly during a tie.
-func Showdown(players []*Player, pots []*Pot) (map[*Player]int, []*Pot) {
-	winnerTiers := WinnerTiers(players)
+// If a pot has no potential winners among the players, ErrUnclaimedPot is
+// returned and none of the pots are paid out.
+func Showdown(players []*Player, pots []*Pot) (map[*Player]int, []*Pot, error) {
+	return showdown(players, pots, nil)
+}
+
+// ShowdownWith is like Showdown, but ranks every player's hand with the
+// given evaluator, regardless of the rules it was made with
+func ShowdownWith(players []*Player, pots []*Pot, e Evaluator) (map[*Player]int, []*Pot, error) {
+	return showdown(players, pots, e)
+}
+
+func showdown(players []*Player, pots []*Pot, e Evaluator) (map[*Player]int, []*Pot, error) {
+	winnerTiers, err := winnerTiers(players, e)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Make sure every pot can be won before paying any of them out
+	for _, pot := range pots {
+		if pot != nil && !pot.claimableBy(players) {
+			return nil, nil, ErrUnclaimedPot
+		}
+	}
+
 	payouts := make(map[*Player]int)
 	oddChips := []*Pot{}
 
@@ -39,54 +63,183 @@ func Showdown(players []*Player, pots []*Pot) (map[*Player]int, []*Pot) {
 				continue
 			}
 
-			for _, winner := range potWinners {
-				payouts[winner] += pot.Value / numOfWinners
-			}
-
-			if pot.Value%numOfWinners != 0 {
-				oddChipPot := NewPot(pot.Value%numOfWinners, potWinners)
-				oddChips = append(oddChips, oddChipPot)
-			}
+			oddChips = divide(pot.Value, potWinners, payouts, oddChips)
 
 			// Nil out this pot so nobody can win it again!
 			pots[i] = nil
 		}
 	}
 
-	// Sanity check
-	for i := range pots {
-		if pots[i] != nil {
-			panic("All pots should be paid out at end of showdown!")
+	return payouts, oddChips, nil
+}
+
+// SplitPotShowdown is like Showdown for split pot games such as Omaha
+// Hi-Lo, where each pot is divided between the best high hand and the best
+// low hand. Every player must have a hand, and players who may win the low
+// half must also have been given a low hand with GetLowHand. Low hands only
+// count if they qualify with no card higher than the given rank, e.g.
+// EightOrBetter, and if nobody eligible for a pot has a qualifying low
+// hand, the high hand scoops the whole pot. When a pot can't be halved
+// evenly, the odd chip goes to the high half, and any chips left over from
+// dividing a half between tied players are returned as odd chips. As for
+// WinnerTiers, hands holding jokers must be ranked by wild card rules.
+func SplitPotShowdown(players []*Player, pots []*Pot, qualifier Rank) (map[*Player]int, []*Pot, error) {
+	highTiers, err := WinnerTiers(players)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, player := range players {
+		if player.lowHand != nil {
+			if err := checkRankable(player, player.lowHand, nil); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	lowTiers := tiers(players, func(p *Player) *Hand {
+		if p.lowHand == nil || !p.lowHand.QualifiesForLow(qualifier) {
+			return nil
+		}
+		return p.lowHand
+	}, nil)
+
+	for _, pot := range pots {
+		if pot != nil && !pot.claimableBy(players) {
+			return nil, nil, ErrUnclaimedPot
 		}
 	}
 
+	payouts := make(map[*Player]int)
+	oddChips := []*Pot{}
+	for i, pot := range pots {
+		if pot == nil {
+			continue
+		}
+
+		highWinners := pot.bestTier(highTiers)
+		lowWinners := pot.bestTier(lowTiers)
+		if len(lowWinners) == 0 {
+			oddChips = divide(pot.Value, highWinners, payouts, oddChips)
+		} else {
+			low := pot.Value / 2
+			oddChips = divide(pot.Value-low, highWinners, payouts, oddChips)
+			oddChips = divide(low, lowWinners, payouts, oddChips)
+		}
+
+		// Nil out this pot so nobody can win it again, as in Showdown
+		pots[i] = nil
+	}
+
+	return payouts, oddChips, nil
+}
+
+// MustSplitPotShowdown is like SplitPotShowdown but panics if the showdown
+// is invalid
+func MustSplitPotShowdown(players []*Player, pots []*Pot, qualifier Rank) (map[*Player]int, []*Pot) {
+	payouts, oddChips, err := SplitPotShowdown(players, pots, qualifier)
+	if err != nil {
+		panic(err)
+	}
+	return payouts, oddChips
+}
+
+// Divides chips evenly among winners, adding to their payouts, and
+// returns oddChips with a pot of any chips left over added
+func divide(value int, winners []*Player, payouts map[*Player]int, oddChips []*Pot) []*Pot {
+	for _, winner := range winners {
+		payouts[winner] += value / len(winners)
+	}
+	if value%len(winners) != 0 {
+		oddChips = append(oddChips, NewPot(value%len(winners), winners))
+	}
+	return oddChips
+}
+
+// MustShowdown is like Showdown but panics if the showdown is invalid
+func MustShowdown(players []*Player, pots []*Pot) (map[*Player]int, []*Pot) {
+	payouts, oddChips, err := Showdown(players, pots)
+	if err != nil {
+		panic(err)
+	}
 	return payouts, oddChips
 }
 
 // WinnerTiers divides players into ranks ordered by winning poker hand,
 // with all players who tied for the best hand at index 0, those who
 // tied for 2nd best at index 1, and so on. There must be at least two
-// players, and all players included must have a hand assigned.
-func WinnerTiers(players []*Player) [][]*Player {
+// players, and all players included must have a hand assigned, otherwise
+// ErrNotEnoughPlayers or ErrPlayerWithoutHand is returned. A hand holding
+// a joker must be ranked by wild card rules, otherwise ErrUnexpectedJoker
+// is returned.
+func WinnerTiers(players []*Player) ([][]*Player, error) {
+	return winnerTiers(players, nil)
+}
+
+// WinnerTiersWith is like WinnerTiers, but ranks every player's hand with
+// the given evaluator, regardless of the rules it was made with
+func WinnerTiersWith(players []*Player, e Evaluator) ([][]*Player, error) {
+	return winnerTiers(players, e)
+}
+
+func winnerTiers(players []*Player, e Evaluator) ([][]*Player, error) {
 	if len(players) < 2 {
-		panic("There must be at least two participants, or the winner is already decided.")
+		return nil, ErrNotEnoughPlayers
 	}
 
-	hands := make(HandGroup, len(players))
-	for i, player := range players {
+	for _, player := range players {
 		if player.hand == nil {
-			panic("All players involved in a showdown must have a hand!")
+			return nil, fmt.Errorf("%w: %s has none", ErrPlayerWithoutHand, player)
+		}
+		if err := checkRankable(player, player.hand, e); err != nil {
+			return nil, err
 		}
-		hands[i] = player.hand
 	}
-	sort.Sort(hands)
+	return tiers(players, func(p *Player) *Hand { return p.hand }, e), nil
+}
+
+// Returns ErrUnexpectedJoker if the player's hand holds a joker and is
+// ranked, by the evaluator or its own rules if that is nil, without wild
+// cards
+func checkRankable(p *Player, h *Hand, e Evaluator) error {
+	if e == nil {
+		e = h.evaluator
+	}
+	if h.hasJoker() && !allowsJokers(e) {
+		return fmt.Errorf("%w: %s's hand holds one", ErrUnexpectedJoker, p)
+	}
+	return nil
+}
+
+// Divides the players who have a hand, as given by the function provided,
+// into tiers as for WinnerTiers, ranking hands with the evaluator or by
+// their own rules if it is nil. Players without a hand are left out.
+func tiers(players []*Player, hand func(*Player) *Hand, e Evaluator) [][]*Player {
+	hands := HandGroup{}
+	values := make(map[*Hand][]int)
+	for _, player := range players {
+		h := hand(player)
+		if h == nil {
+			continue
+		}
+		hands = append(hands, h)
+		if e == nil {
+			values[h] = h.Rank().Value()
+		} else {
+			values[h] = e.Rank(*h).Value()
+		}
+	}
+	if len(hands) == 0 {
+		return [][]*Player{}
+	}
+	sort.SliceStable(hands, func(i, j int) bool {
+		return compareValues(values[hands[i]], values[hands[j]]) < 0
+	})
 
 	winners := [][]*Player{}
 	winningHandForTier := hands[len(hands)-1]
 	winnersForTier := []*Player{winningHandForTier.owner}
 	for i := len(hands) - 2; i >= 0; i-- {
 		hand := hands[i]
-		if winningHandForTier.IsEqual(hand) {
+		if compareValues(values[winningHandForTier], values[hand]) == 0 {
 			winnersForTier = append(winnersForTier, hand.owner)
 		} else {
 			winners = append(winners, winnersForTier)
@@ -97,3 +250,13 @@ func WinnerTiers(players []*Player) [][]*Player {
 	winners = append(winners, winnersForTier)
 	return winners
 }
+
+// MustWinnerTiers is like WinnerTiers but panics if there are fewer
+// than two players or a player has no hand
+func MustWinnerTiers(players []*Player) [][]*Player {
+	tiers, err := WinnerTiers(players)
+	if err != nil {
+		panic(err)
+	}
+	return tiers
+}
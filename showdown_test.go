@@ -1,6 +1,8 @@
 package goker_test
 
 import (
+	"errors"
+
 	. "github.com/sozorogami/goker"
 
 	. "github.com/onsi/ginkgo"
@@ -27,21 +29,51 @@ var _ = Describe("Showdown", func() {
 	})
 	Describe("finding the winners", func() {
 		Context("when there are not enough players", func() {
-			It("panics", func() {
+			It("returns an error", func() {
+				charlie.GetHand(royalStraightFlush)
+				_, err := WinnerTiers([]*Player{charlie})
+				Expect(err).To(MatchError(ErrNotEnoughPlayers))
+			})
+			It("panics if you insist", func() {
 				charlie.GetHand(royalStraightFlush)
 				Expect(func() {
-					WinnerTiers([]*Player{charlie})
+					MustWinnerTiers([]*Player{charlie})
 				}).To(Panic())
 			})
 		})
 		Context("when there is a player without a hand", func() {
-			It("panics", func() {
+			It("returns an error", func() {
+				dennis.GetHand(royalStraightFlush)
+				_, err := WinnerTiers([]*Player{dennis, charlie})
+				Expect(errors.Is(err, ErrPlayerWithoutHand)).To(BeTrue())
+			})
+			It("panics if you insist", func() {
 				dennis.GetHand(royalStraightFlush)
 				Expect(func() {
-					WinnerTiers([]*Player{dennis, charlie})
+					MustWinnerTiers([]*Player{dennis, charlie})
 				}).To(Panic())
 			})
 		})
+		Context("when a player holds a joker without wild card rules", func() {
+			cards := mustParse("Ad Kd Qd Jd")
+			joker := NewHand(cards[0], cards[1], cards[2], cards[3], NewJoker(Red))
+			It("returns an error", func() {
+				dennis.GetHand(royalStraightFlush)
+				charlie.GetHand(joker)
+				_, err := WinnerTiers([]*Player{dennis, charlie})
+				Expect(err).To(MatchError(ErrUnexpectedJoker))
+				_, _, err = Showdown([]*Player{dennis, charlie}, []*Pot{NewPot(10, []*Player{dennis, charlie})})
+				Expect(err).To(MatchError(ErrUnexpectedJoker))
+			})
+			It("ranks it with wild card rules if asked", func() {
+				dennis.GetHand(royalStraightFlush)
+				charlie.GetHand(joker)
+				tiers, err := WinnerTiersWith([]*Player{dennis, charlie}, JokersWild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tiers).To(HaveLen(1))
+				Expect(tiers[0]).To(ConsistOf(dennis, charlie))
+			})
+		})
 		Context("when a player has a winning hand", func() {
 			winner := royalStraightFlush
 			loser := highCard
@@ -50,7 +82,7 @@ var _ = Describe("Showdown", func() {
 				dennis.GetHand(loser)
 			})
 			It("finds one winner on two tiers", func() {
-				Expect(WinnerTiers([]*Player{charlie, dennis})).To(Equal([][]*Player{[]*Player{charlie}, []*Player{dennis}}))
+				Expect(MustWinnerTiers([]*Player{charlie, dennis})).To(Equal([][]*Player{[]*Player{charlie}, []*Player{dennis}}))
 			})
 		})
 		Context("when there is a tie", func() {
@@ -61,14 +93,33 @@ var _ = Describe("Showdown", func() {
 				dennis.GetHand(otherWinner)
 			})
 			It("finds two winners on one tier", func() {
-				result := WinnerTiers([]*Player{charlie, dennis})[0]
+				result := MustWinnerTiers([]*Player{charlie, dennis})[0]
 				Expect(result).To(ConsistOf(charlie, dennis))
 			})
 		})
 	})
 
 	Describe("dividing pots", func() {
-		Context("", func() {})
+		Context("when nobody in the showdown can win a pot", func() {
+			BeforeEach(func() {
+				charlie.GetHand(royalStraightFlush)
+				dennis.GetHand(highCard)
+			})
+			It("returns an error without paying out any pots", func() {
+				winnable := NewPot(100, []*Player{charlie, dennis})
+				unwinnable := NewPot(50, []*Player{dee, mac})
+				pots := []*Pot{winnable, unwinnable}
+				results, _, err := Showdown([]*Player{charlie, dennis}, pots)
+				Expect(err).To(MatchError(ErrUnclaimedPot))
+				Expect(results).To(BeEmpty())
+				Expect(pots).To(Equal([]*Pot{winnable, unwinnable}))
+			})
+			It("panics if you insist", func() {
+				Expect(func() {
+					MustShowdown([]*Player{charlie, dennis}, []*Pot{NewPot(50, []*Player{dee})})
+				}).To(Panic())
+			})
+		})
 	})
 	Context("when there is only one pot", func() {
 		onlyPot := NewPot(1000, []*Player{charlie, dennis, dee, mac})
@@ -84,7 +135,7 @@ var _ = Describe("Showdown", func() {
 				mac.GetHand(loser3)
 			})
 			It("gives the whole pot to the winning player", func() {
-				results, oddChips := Showdown([]*Player{charlie, dennis, dee, mac}, []*Pot{onlyPot})
+				results, oddChips := MustShowdown([]*Player{charlie, dennis, dee, mac}, []*Pot{onlyPot})
 				Expect(results[charlie]).To(Equal(1000))
 				Expect(results[dennis]).To(BeZero())
 				Expect(oddChips).To(BeEmpty())
@@ -103,7 +154,7 @@ var _ = Describe("Showdown", func() {
 					mac.GetHand(loser2)
 				})
 				It("divides the pot evenly between the winners", func() {
-					results, oddChips := Showdown([]*Player{charlie, dennis, dee, mac}, []*Pot{onlyPot})
+					results, oddChips := MustShowdown([]*Player{charlie, dennis, dee, mac}, []*Pot{onlyPot})
 					Expect(results[charlie]).To(Equal(500))
 					Expect(results[dennis]).To(Equal(500))
 					Expect(results[dee]).To(BeZero())
@@ -129,7 +180,7 @@ var _ = Describe("Showdown", func() {
 					mac.GetHand(loser)
 				})
 				It("divides the pot evenly, with odd chips returned separately", func() {
-					results, oddChips := Showdown([]*Player{charlie, dennis, dee, mac}, []*Pot{onlyPot})
+					results, oddChips := MustShowdown([]*Player{charlie, dennis, dee, mac}, []*Pot{onlyPot})
 					Expect(results[charlie]).To(Equal(333))
 					Expect(results[dennis]).To(Equal(333))
 					Expect(results[dee]).To(Equal(333))
@@ -161,7 +212,7 @@ var _ = Describe("Showdown", func() {
 				charlie.GetHand(loser)
 				dennis.GetHand(loser2)
 				mac.GetHand(loser3)
-				results, _ = Showdown([]*Player{dee, charlie, mac, dennis}, pots)
+				results, _ = MustShowdown([]*Player{dee, charlie, mac, dennis}, pots)
 			})
 			It("gives the side pot to the winnner ", func() {
 				Expect(results[dee]).To(Equal(sidePot.Value))
@@ -176,7 +227,7 @@ var _ = Describe("Showdown", func() {
 				charlie.GetHand(loser)
 				dennis.GetHand(winner)
 				mac.GetHand(loser2)
-				results, _ = Showdown([]*Player{dee, charlie, mac, dennis}, pots)
+				results, _ = MustShowdown([]*Player{dee, charlie, mac, dennis}, pots)
 			})
 			It("gives both pots to the winner", func() {
 				Expect(results[dennis]).To(Equal(sidePot.Value + mainPot.Value))
@@ -189,7 +240,7 @@ var _ = Describe("Showdown", func() {
 				charlie.GetHand(loser)
 				dennis.GetHand(otherRoyalStraightFlush)
 				mac.GetHand(loser2)
-				results, oddChips = Showdown([]*Player{dee, charlie, mac, dennis}, pots)
+				results, oddChips = MustShowdown([]*Player{dee, charlie, mac, dennis}, pots)
 			})
 			It("gives the main pot to the person in the main pot and splits the side pot", func() {
 				Expect(results[dennis]).To(Equal(mainPot.Value + sidePot.Value/2))
@@ -201,3 +252,99 @@ var _ = Describe("Showdown", func() {
 		})
 	})
 })
+
+var _ = Describe("Split pot showdown", func() {
+	charlie := NewPlayer("Charlie")
+	dennis := NewPlayer("Dennis")
+	dee := NewPlayer("Dee")
+	AfterEach(func() {
+		charlie.MuckHand()
+		dennis.MuckHand()
+		dee.MuckHand()
+	})
+	BeforeEach(func() {
+		charlie.GetHand(royalStraightFlush)
+		dennis.GetHand(pair)
+		dee.GetHand(highCard)
+	})
+
+	It("divides the pot between the high and low hands, odd chip to the high", func() {
+		dennis.GetLowHand(aceToFive("7c 5d 4h 3s 2c"))
+		dee.GetLowHand(aceToFive("8c 5d 4h 3s 2c"))
+		payouts, oddChips := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(101, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 51, dennis: 50}))
+		Expect(oddChips).To(BeEmpty())
+	})
+
+	It("gives the whole pot to the high hand when nobody has a low hand", func() {
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(101, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 101}))
+	})
+
+	It("gives the whole pot to the high hand when no low hand qualifies", func() {
+		dennis.GetLowHand(aceToFive("9c 5d 4h 3s 2c"))
+		dee.GetLowHand(aceToFive("8c 8d 4h 3s 2c"))
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(101, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 101}))
+	})
+
+	It("ignores a low hand that doesn't qualify", func() {
+		dennis.GetLowHand(aceToFive("9c 5d 4h 3s 2c"))
+		dee.GetLowHand(aceToFive("8c 6d 4h 3s 2c"))
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(100, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 50, dee: 50}))
+	})
+
+	It("keeps a low hand however the hands were given out", func() {
+		dennis.GetLowHand(aceToFive("7c 5d 4h 3s 2c"))
+		dennis.GetHand(twoPair)
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(100, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 50, dennis: 50}))
+	})
+
+	It("forgets low hands once they are mucked", func() {
+		dennis.GetLowHand(aceToFive("7c 5d 4h 3s 2c"))
+		dennis.MuckHand()
+		dennis.GetHand(twoPair)
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(100, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 100}))
+	})
+
+	It("pays out each pot only once, like Showdown", func() {
+		dennis.GetLowHand(aceToFive("7c 5d 4h 3s 2c"))
+		pots := []*Pot{NewPot(100, []*Player{charlie, dennis, dee}), NewPot(40, []*Player{dennis, dee})}
+		MustSplitPotShowdown([]*Player{charlie, dennis, dee}, pots, EightOrBetter)
+		Expect(pots).To(Equal([]*Pot{nil, nil}))
+	})
+
+	It("lets one player scoop both halves", func() {
+		charlie.GetLowHand(aceToFive("5c 4d 3h 2s Ac"))
+		dennis.GetLowHand(aceToFive("7c 5d 4h 3s 2c"))
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis}, []*Pot{NewPot(100, []*Player{charlie, dennis})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 100}))
+	})
+
+	It("quarters the pot when the low half is tied", func() {
+		charlie.GetLowHand(aceToFive("6c 4d 3h 2s Ac"))
+		dennis.GetLowHand(aceToFive("6d 4c 3c 2d Ad"))
+		payouts, oddChips := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{NewPot(102, []*Player{charlie, dennis, dee})}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 76, dennis: 25}))
+		Expect(oddChips).To(HaveLen(1))
+		Expect(oddChips[0].Value).To(Equal(1))
+		Expect(oddChips[0].PotentialWinners).To(HaveLen(2))
+	})
+
+	It("gives the low half of a side pot to the best low hand entitled to it", func() {
+		charlie.GetLowHand(aceToFive("6c 4d 3h 2s Ac"))
+		dee.GetLowHand(aceToFive("8c 5d 4h 3s 2c"))
+		mainPot := NewPot(90, []*Player{charlie, dennis, dee})
+		sidePot := NewPot(40, []*Player{dennis, dee})
+		payouts, _ := MustSplitPotShowdown([]*Player{charlie, dennis, dee}, []*Pot{mainPot, sidePot}, EightOrBetter)
+		Expect(payouts).To(Equal(map[*Player]int{charlie: 90, dennis: 20, dee: 20}))
+	})
+
+	It("returns an error when a pot can't be claimed", func() {
+		_, _, err := SplitPotShowdown([]*Player{charlie, dennis}, []*Pot{NewPot(10, []*Player{dee})}, EightOrBetter)
+		Expect(err).To(MatchError(ErrUnclaimedPot))
+	})
+})
@@ -0,0 +1,63 @@
+package goker
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// Shuffler supplies the randomness used to shuffle a deck. It is
+// satisfied by *rand.Rand from math/rand.
+type Shuffler interface {
+	// Intn returns a uniformly distributed random integer in [0, n)
+	Intn(n int) int
+}
+
+// Picks a seed for a deck that wasn't given one, without touching
+// the global math/rand state
+func randomSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
+
+// CryptoShuffler is a Shuffler backed by crypto/rand, for tables where
+// the order of the deck must not be predictable from cards already seen.
+// It panics if the operating system's random number generator fails.
+type CryptoShuffler struct{}
+
+// Intn returns a uniformly distributed random integer in [0, n)
+func (CryptoShuffler) Intn(n int) int {
+	return uniformIntn(n, func() uint64 {
+		var b [8]byte
+		if _, err := crand.Read(b[:]); err != nil {
+			panic(err)
+		}
+		return binary.LittleEndian.Uint64(b[:])
+	})
+}
+
+// NewSecureDeck constructs a standard 52 card deck shuffled by a
+// CryptoShuffler. Its deal cannot be replayed from a seed.
+func NewSecureDeck() *Deck {
+	return NewDeckWithShuffler(CryptoShuffler{})
+}
+
+// Maps uniformly distributed 64-bit values from next onto [0, n) without
+// modulo bias, by rejecting values above the largest multiple of n
+func uniformIntn(n int, next func() uint64) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	bound := uint64(n)
+	limit := math.MaxUint64 - math.MaxUint64%bound
+	for {
+		if v := next(); v < limit {
+			return int(v % bound)
+		}
+	}
+}
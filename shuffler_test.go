@@ -0,0 +1,142 @@
+package goker_test
+
+import (
+	"math/rand"
+
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Shuffles a deck many times and measures how far the number of times
+// each card lands in each position strays from a uniform distribution,
+// as a chi-square statistic with 51 * 51 degrees of freedom
+func positionChiSquare(deck *Deck, shuffles int) float64 {
+	counts := map[Card][]int{}
+	for i := 0; i < shuffles; i++ {
+		deck.Reset()
+		cards, _ := deck.Draw(52)
+		for pos, card := range cards {
+			if counts[*card] == nil {
+				counts[*card] = make([]int, 52)
+			}
+			counts[*card][pos]++
+		}
+	}
+
+	expected := float64(shuffles) / 52
+	chiSquare := 0.0
+	for _, positions := range counts {
+		for _, observed := range positions {
+			diff := float64(observed) - expected
+			chiSquare += diff * diff / expected
+		}
+	}
+	return chiSquare
+}
+
+// Always picks the highest index, which leaves a deck in its original order
+type noopShuffler struct{ calls int }
+
+func (s *noopShuffler) Intn(n int) int {
+	s.calls++
+	return n - 1
+}
+
+var _ = Describe("Shuffling", func() {
+	It("deals the same cards from decks with the same seed", func() {
+		first, _ := NewSeededDeck(42).Draw(52)
+		second, _ := NewSeededDeck(42).Draw(52)
+		Expect(first).To(Equal(second))
+
+		other, _ := NewSeededDeck(43).Draw(52)
+		Expect(first).NotTo(Equal(other))
+	})
+
+	It("records the seed so a deal can be replayed", func() {
+		deck := NewDeck()
+		dealt, _ := deck.Draw(9)
+
+		seed, ok := deck.Seed()
+		Expect(ok).To(BeTrue())
+		replayed, _ := NewSeededDeck(seed).Draw(9)
+		Expect(replayed).To(Equal(dealt))
+	})
+
+	It("replays resets as well as the first deal", func() {
+		deck, replay := NewSeededDeck(7), NewSeededDeck(7)
+		deck.Reset()
+		replay.Reset()
+		first, _ := deck.Draw(5)
+		second, _ := replay.Draw(5)
+		Expect(first).To(Equal(second))
+	})
+
+	It("replays a deal after a reset from its own seed", func() {
+		deck := NewDeck()
+		first, _ := deck.Draw(9)
+		firstSeed, _ := deck.Seed()
+		deck.Reset()
+		deck.Reset()
+		dealt, _ := deck.Draw(9)
+
+		seed, ok := deck.Seed()
+		Expect(ok).To(BeTrue())
+		Expect(seed).NotTo(Equal(firstSeed))
+		replayed, _ := NewSeededDeck(seed).Draw(9)
+		Expect(replayed).To(Equal(dealt))
+		Expect(replayed).NotTo(Equal(first))
+	})
+
+	It("can be driven by any source", func() {
+		first, _ := NewDeckWithSource(rand.NewSource(3)).Draw(52)
+		second, _ := NewDeckWithSource(rand.NewSource(3)).Draw(52)
+		Expect(first).To(Equal(second))
+
+		_, ok := NewDeckWithSource(rand.NewSource(3)).Seed()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("can be driven by a custom shuffler", func() {
+		s := &noopShuffler{}
+		deck := NewDeckWithShuffler(s)
+		Expect(s.calls).To(Equal(51))
+
+		top, _ := deck.Draw(1)
+		Expect(top[0]).To(Equal(NewCard(Ace, Club)))
+	})
+
+	Describe("securely", func() {
+		It("deals every card", func() {
+			cards, _ := NewSecureDeck().Draw(52)
+			Expect(cards.Mask()).To(Equal(FullDeckMask))
+		})
+
+		It("can't be replayed from a seed", func() {
+			_, ok := NewSecureDeck().Seed()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("picks indices in range", func() {
+			s := CryptoShuffler{}
+			for n := 1; n <= 52; n++ {
+				Expect(s.Intn(n)).To(And(BeNumerically(">=", 0), BeNumerically("<", n)))
+			}
+			Expect(func() { s.Intn(0) }).To(Panic())
+		})
+
+		// The statistic has mean 2601 and standard deviation about 72, so
+		// a uniform shuffle exceeds five deviations above the mean with
+		// negligible probability
+		const chiSquareLimit = 2601 + 5*72
+
+		It("puts every card in every position equally often", func() {
+			Expect(positionChiSquare(NewSecureDeck(), 20000)).To(BeNumerically("<", chiSquareLimit))
+		})
+
+		It("would notice a shuffle that isn't uniform", func() {
+			Expect(positionChiSquare(NewDeckWithShuffler(&noopShuffler{}), 20000)).To(BeNumerically(">", chiSquareLimit))
+		})
+	})
+})
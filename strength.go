@@ -0,0 +1,171 @@
+package goker
+
+import "math/bits"
+
+// Strength is the value of a poker hand packed into a single integer, such
+// that a stronger hand always has a greater strength and hands of equal
+// value have equal strengths. The category of the hand, as given by the
+// first element of its rank's Value(), is held in bits 20-23, followed by
+// the remaining elements of Value() in four bits each, most significant
+// first.
+type Strength uint32
+
+// Category returns the kind of hand the strength is for. It only applies
+// to the strengths of hands ranked by high hand rules.
+func (s Strength) Category() Category {
+	return Category(s >> 20)
+}
+
+// Strength evaluates the best five card poker hand that can be made from
+// the cards in the mask, which should hold between five and seven cards.
+// It gives the same ordering as comparing the best possible hands' ranks,
+// but uses precomputed tables rather than building and sorting every
+// combination, and does not allocate.
+func (m CardMask) Strength() Strength {
+	s := uint32(m) & rankMaskAll
+	h := uint32(m>>13) & rankMaskAll
+	d := uint32(m>>26) & rankMaskAll
+	c := uint32(m>>39) & rankMaskAll
+
+	// With seven cards or fewer, a flush rules out quads and full houses,
+	// so it is safe to check for one first
+	for _, suited := range [4]uint32{s, h, d, c} {
+		if bits.OnesCount32(suited) < 5 {
+			continue
+		}
+		if high := straightTable[suited]; high != 0 {
+			if high == Ace {
+				return packStrength(9, 0)
+			}
+			return packStrength(8, uint32(high)<<16)
+		}
+		return packStrength(5, topFiveTable[suited])
+	}
+
+	ranks := s | h | d | c
+	quads := s & h & d & c
+	trips := (s & h & d) | (s & h & c) | (s & d & c) | (h & d & c)
+	pairs := (s & h) | (s & d) | (s & c) | (h & d) | (h & c) | (d & c)
+
+	if quads != 0 {
+		quad := topRanks(quads, 1)
+		kicker := topRanks(ranks&^rankBit(quad), 1)
+		return packStrength(7, quad<<16|kicker<<12)
+	}
+
+	if trips != 0 {
+		trip := topRanks(trips, 1)
+		if otherPairs := pairs &^ rankBit(trip); otherPairs != 0 {
+			return packStrength(6, trip<<16|topRanks(otherPairs, 1)<<12)
+		}
+	}
+
+	if high := straightTable[ranks]; high != 0 {
+		return packStrength(4, uint32(high)<<16)
+	}
+
+	if trips != 0 {
+		trip := topRanks(trips, 1)
+		kickers := topRanks(ranks&^rankBit(trip), 2)
+		return packStrength(3, trip<<16|kickers<<8)
+	}
+
+	switch bits.OnesCount32(pairs) {
+	case 0:
+		return packStrength(0, topFiveTable[ranks])
+	case 1:
+		kickers := topRanks(ranks&^pairs, 3)
+		return packStrength(1, topRanks(pairs, 1)<<16|kickers<<4)
+	default:
+		bothPairs := topRanks(pairs, 2)
+		kicker := topRanks(ranks&^rankBit(bothPairs>>4)&^rankBit(bothPairs&0xF), 1)
+		return packStrength(2, bothPairs<<12|kicker<<8)
+	}
+}
+
+// Strength evaluates the best five card poker hand that can be made
+// from the cards in the set, which should hold between five and seven
+// cards. See CardMask.Strength. Sets with duplicate cards, e.g. dealt
+// from a Shoe, can't be held in a mask. Five or more of them are
+// evaluated by ranking every possible hand instead, and fewer, which
+// can't make a straight or flush, by their ranks alone, so A♠ A♠ K♠ is a
+// pair of aces. A set holding a joker is unrankable, as for Hand.Rank,
+// and has a strength of 0, below every hand; use BestPossibleHandWith to
+// play jokers as wild cards.
+func (c CardSet) Strength() Strength {
+	if c.hasJoker() {
+		return 0
+	}
+	m := c.Mask()
+	if m.Count() == len(c) {
+		return m.Strength()
+	}
+	if len(c) >= 5 {
+		return c.MustBestPossibleHand().Strength()
+	}
+	return c.rankMask().Strength()
+}
+
+// The cards as a mask with each card of a rank given a suit of its own,
+// which keeps every card of a set of four or fewer
+func (c CardSet) rankMask() CardMask {
+	var m CardMask
+	seen := make(map[Rank]int)
+	for _, card := range c {
+		m = m.Add(NewPackedCard(card.Rank, Suit(seen[card.Rank])))
+		seen[card.Rank]++
+	}
+	return m
+}
+
+// Within a single suit of a card mask, rank r is held in bit r-2
+const rankMaskAll = 1<<13 - 1
+
+func rankBit(r uint32) uint32 {
+	return 1 << (r - uint32(Two))
+}
+
+func packStrength(category int, ranks uint32) Strength {
+	return Strength(uint32(category)<<20 | ranks)
+}
+
+// Returns the n highest ranks in the rank mask, packed four bits apiece
+// with the highest rank most significant
+func topRanks(mask uint32, n int) uint32 {
+	return topFiveTable[mask] >> (4 * uint(5-n))
+}
+
+// For every possible set of ranks, straightTable holds the high card of
+// the best straight it contains, or zero if there is none, and
+// topFiveTable holds its five highest ranks packed as in a Strength
+var (
+	straightTable [rankMaskAll + 1]Rank
+	topFiveTable  [rankMaskAll + 1]uint32
+)
+
+func init() {
+	wheel := rankBit(uint32(Ace)) | rankBit(uint32(Five)) |
+		rankBit(uint32(Four)) | rankBit(uint32(Three)) | rankBit(uint32(Two))
+
+	for mask := uint32(0); mask <= rankMaskAll; mask++ {
+		for high := Ace; high >= Six; high-- {
+			run := uint32(0x1F) << (uint32(high) - uint32(Six))
+			if mask&run == run {
+				straightTable[mask] = high
+				break
+			}
+		}
+		if straightTable[mask] == 0 && mask&wheel == wheel {
+			straightTable[mask] = Five
+		}
+
+		packed, shift := uint32(0), 16
+		for r := Ace; r >= Two && shift >= 0; r-- {
+			if mask&rankBit(uint32(r)) != 0 {
+				packed |= uint32(r) << uint(shift)
+				shift -= 4
+			}
+		}
+		topFiveTable[mask] = packed
+	}
+}
@@ -0,0 +1,107 @@
+package goker_test
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Evaluating hand strength", func() {
+	It("agrees with Rank() for every five card hand", func() {
+		deck := make([]*Card, 52)
+		for i := range deck {
+			deck[i] = PackedCard(i).Card()
+		}
+
+		count := 0
+		for a := 0; a < 52; a++ {
+			for b := a + 1; b < 52; b++ {
+				for c := b + 1; c < 52; c++ {
+					for d := c + 1; d < 52; d++ {
+						for e := d + 1; e < 52; e++ {
+							mask := PackedCard(a).Mask() | PackedCard(b).Mask() |
+								PackedCard(c).Mask() | PackedCard(d).Mask() | PackedCard(e).Mask()
+							hand := NewHand(deck[a], deck[b], deck[c], deck[d], deck[e])
+							if mask.Strength() != hand.Strength() {
+								Fail("strength disagrees with rank for " + mask.String())
+							}
+							count++
+						}
+					}
+				}
+			}
+		}
+		Expect(count).To(Equal(2598960))
+	})
+
+	It("agrees with BestPossibleHand for six and seven cards", func() {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 5000; i++ {
+			n := 6 + i%2
+			cards := CardSet{}
+			for _, j := range r.Perm(52)[:n] {
+				cards = append(cards, PackedCard(j).Card())
+			}
+			Expect(cards.Strength()).To(Equal(cards.MustBestPossibleHand().Strength()), cards.Mask().String())
+		}
+	})
+
+	It("orders hands of different categories", func() {
+		ordered := HandGroup{highCard, pair, twoPair, threeOfAKind, aceLowStraight, straight,
+			flush, fullHouse, fourOfAKind, straightFlush, royalStraightFlush}
+		for i := 1; i < len(ordered); i++ {
+			lower := CardSet{}
+			higher := CardSet{}
+			for j := range ordered[i].Cards {
+				lower = append(lower, &ordered[i-1].Cards[j])
+				higher = append(higher, &ordered[i].Cards[j])
+			}
+			Expect(lower.Strength()).To(BeNumerically("<", higher.Strength()))
+		}
+	})
+
+	It("evaluates duplicate cards from several decks", func() {
+		aces := CardSet{NewCard(Ace, Spade), NewCard(Ace, Spade), NewCard(Ace, Heart),
+			NewCard(Ace, Diamond), NewCard(Ace, Club), NewCard(King, Spade)}
+		Expect(aces.Strength()).To(Equal(aces.MustBestPossibleHand().Strength()))
+		Expect(aces.Strength().Category()).To(Equal(FiveOfAKind))
+
+		short := CardSet{NewCard(Ace, Spade), NewCard(Ace, Spade), NewCard(King, Spade)}
+		Expect(short.Strength()).To(Equal(mustParse("As Ah Ks").Strength()))
+		Expect(short.Strength().Category()).To(Equal(Pair))
+
+		quads := CardSet{NewCard(Two, Club), NewCard(Two, Club), NewCard(Two, Club), NewCard(Two, Club)}
+		Expect(quads.Strength()).To(Equal(mustParse("2s 2h 2d 2c").Strength()))
+		Expect(quads.Strength().Category()).To(Equal(FourOfAKind))
+	})
+
+	It("ranks a set with a joker below every hand", func() {
+		cards := append(mustParse("Js Qh Kc Ad"), NewJoker(Red))
+		Expect(cards.Strength()).To(BeZero())
+		Expect(cards.Strength()).To(BeNumerically("<", mustParse("2c 3d 4h 5s 7c").Strength()))
+	})
+})
+
+// Evaluating a seven card hand should take well under a microsecond and
+// not allocate
+func BenchmarkCardMaskStrength(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	masks := make([]CardMask, 1024)
+	for i := range masks {
+		for _, j := range r.Perm(52)[:7] {
+			masks[i] = masks[i].Add(PackedCard(j))
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var s Strength
+	for i := 0; i < b.N; i++ {
+		s |= masks[i%len(masks)].Strength()
+	}
+	_ = s
+}
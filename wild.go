@@ -0,0 +1,160 @@
+package goker
+
+import "sort"
+
+// WildRules rank hands by the usual rules of poker, except that wild cards
+// play as whichever card makes the best hand, so five of a kind is
+// possible and beats a straight flush. Jokers are always wild.
+type WildRules struct {
+	// WildRank is wild as well as jokers, e.g. Two for deuces wild, or
+	// no rank if zero
+	WildRank Rank
+
+	// Bug limits jokers to playing as an ace, or as any card that
+	// completes a straight or flush. Cards of the wild rank are not
+	// limited.
+	Bug bool
+}
+
+var (
+	// JokersWild ranks hands with any jokers fully wild
+	JokersWild = WildRules{}
+
+	// DeucesWild ranks hands with twos and any jokers fully wild
+	DeucesWild = WildRules{WildRank: Two}
+
+	// BugJoker ranks hands with any jokers played as the bug
+	BugJoker = WildRules{Bug: true}
+)
+
+// WildCard is a wild card in a hand along with the card it played as
+type WildCard struct {
+	Card Card
+	As   Card
+}
+
+// NewHand returns a pointer to a new hand consisting of the provided
+// cards, ranked by these wild card rules. Hands ranked this way should
+// only be compared with one another.
+func (wr WildRules) NewHand(card1, card2, card3, card4, card5 *Card) *Hand {
+	return NewHandWith(wr, card1, card2, card3, card4, card5)
+}
+
+// BestHand returns the best 5 card hand by these wild card rules that
+// can be made with the hole cards and board, or ErrNotEnoughCards if
+// there are fewer than 5 cards between them
+func (wr WildRules) BestHand(hole, board CardSet) (*Hand, error) {
+	return hole.union(board).bestHand(wr)
+}
+
+// Rank returns the value of the best hand the cards can make with each
+// wild card played as any card, or as the bug allows
+func (wr WildRules) Rank(h Hand) HandRank {
+	naturals := []Card{}
+	wilds := []int{}
+	for i, card := range h.Cards {
+		if card.IsJoker() || (wr.WildRank != 0 && card.Rank == wr.WildRank) {
+			wilds = append(wilds, i)
+		} else {
+			naturals = append(naturals, card)
+		}
+	}
+	if len(wilds) == 0 {
+		return h.highRank()
+	}
+
+	// Wild cards only ever need to share a suit with the natural cards to
+	// complete a flush, and playing them in that suit never makes the
+	// hand worse. Bugs come last, so that when trying the ranks in
+	// ascending order the bugs get the highest, i.e. the aces.
+	suit := Spade
+	if len(naturals) > 0 {
+		suit = naturals[0].Suit
+	}
+	sort.SliceStable(wilds, func(i, j int) bool {
+		return !wr.isBug(h.Cards[wilds[i]]) && wr.isBug(h.Cards[wilds[j]])
+	})
+
+	var best *wildRank
+	var top Strength
+	played := h.Cards
+
+	var try func(w int, from Rank)
+	try = func(w int, from Rank) {
+		if w < len(wilds) {
+			for r := from; r <= Ace; r++ {
+				played[wilds[w]] = Card{r, suit}
+				try(w+1, r)
+			}
+			return
+		}
+
+		hand := Hand{Cards: played}
+		sort.Sort(&hand)
+		rank := hand.highRank()
+		if !wr.bugAllowed(h, played, rank) {
+			return
+		}
+		if s := rankStrength(rank); best == nil || s > top {
+			best, top = newWildRank(rank, h.Cards, played, wilds), s
+		}
+	}
+	try(0, Two)
+	return best
+}
+
+func (wr WildRules) isBug(card Card) bool {
+	return wr.Bug && card.IsJoker()
+}
+
+// True unless a bug plays as something other than an ace in a hand that
+// is not a straight or flush
+func (wr WildRules) bugAllowed(h Hand, played [5]Card, rank HandRank) bool {
+	switch rank.Category() {
+	case Straight, Flush, StraightFlush, RoyalStraightFlush:
+		return true
+	}
+	for i, card := range h.Cards {
+		if wr.isBug(card) && played[i].Rank != Ace {
+			return false
+		}
+	}
+	return true
+}
+
+// Wild
+
+type wildRank struct {
+	rank   HandRank
+	played [5]Card
+	wilds  []WildCard
+}
+
+func newWildRank(rank HandRank, cards, played [5]Card, wilds []int) *wildRank {
+	wr := wildRank{rank: rank, played: played}
+	for _, i := range wilds {
+		wr.wilds = append(wr.wilds, WildCard{cards[i], played[i]})
+	}
+	sort.Slice(wr.wilds, func(i, j int) bool { return wr.wilds[i].As.Rank > wr.wilds[j].As.Rank })
+	return &wr
+}
+
+func (wr wildRank) Value() []int {
+	return wr.rank.Value()
+}
+
+func (wr wildRank) Name() string {
+	return wr.rank.Name()
+}
+
+func (wr wildRank) Category() Category {
+	return wr.rank.Category()
+}
+
+func (wr wildRank) Primary() []Rank {
+	return wr.rank.Primary()
+}
+
+func (wr wildRank) Kickers() []Rank {
+	return wr.rank.Kickers()
+}
@@ -0,0 +1,77 @@
+package goker_test
+
+import (
+	. "github.com/sozorogami/goker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Makes a hand of the parsed cards plus enough jokers to make five
+func wildHand(rules WildRules, s string) *Hand {
+	c := mustParse(s)
+	for len(c) < 5 {
+		c = append(c, NewJoker(Black))
+	}
+	return rules.NewHand(c[0], c[1], c[2], c[3], c[4])
+}
+
+var _ = Describe("Wild cards", func() {
+	It("adds jokers to a deck", func() {
+		deck := NewSeededDeckWithJokers(1, 2)
+		Expect(deck.Len()).To(Equal(54))
+		Expect(deck.Contains(NewJoker(Black))).To(BeTrue())
+		Expect(deck.Contains(NewJoker(Red))).To(BeTrue())
+		Expect(NewJoker(Red).String()).To(Equal("Joker"))
+	})
+
+	It("plays a joker as the card that makes the best hand", func() {
+		Expect(wildHand(JokersWild, "Ah Ad Kc Ks").Rank().Category()).To(Equal(FullHouse))
+		Expect(wildHand(JokersWild, "Th Jh Qh Kh").Rank().Category()).To(Equal(RoyalStraightFlush))
+		Expect(wildHand(JokersWild, "2c 7d 9h Qs").Rank().Primary()).To(Equal([]Rank{Queen}))
+	})
+
+	It("ranks five of a kind above a straight flush", func() {
+		five := wildHand(JokersWild, "Ah Ad Ac As")
+		Expect(five.Rank().Category()).To(Equal(FiveOfAKind))
+		Expect(straightFlush.IsLessThan(five)).To(BeTrue())
+		Expect(royalStraightFlush.IsLessThan(five)).To(BeTrue())
+		Expect(wildHand(JokersWild, "3h 3d 3c 3s").IsLessThan(five)).To(BeTrue())
+	})
+
+	It("makes every card of the wild rank wild", func() {
+		hand := wildHand(DeucesWild, "2c 2d 7h 7s 7c")
+		Expect(hand.Rank().Category()).To(Equal(FiveOfAKind))
+		Expect(hand.Rank().Primary()).To(Equal([]Rank{Seven}))
+		Expect(wildHand(DeucesWild, "2c 2d 2h 2s").Rank().Primary()).To(Equal([]Rank{Ace}))
+		Expect(wildHand(JokersWild, "2c 2d 7h 7s 7c").Rank().Category()).To(Equal(FullHouse))
+	})
+
+	It("limits the bug to aces, straights and flushes", func() {
+		Expect(wildHand(BugJoker, "Kh Kd 7c 4s").Rank().Category()).To(Equal(Pair))
+		Expect(wildHand(BugJoker, "Kh Kd 7c 4s").Rank().Kickers()).To(Equal([]Rank{Ace, Seven, Four}))
+		Expect(wildHand(BugJoker, "Ah Ad 7c 4s").Rank().Category()).To(Equal(ThreeOfAKind))
+		Expect(wildHand(BugJoker, "5h 6d 7c 9s").Rank().Category()).To(Equal(Straight))
+		Expect(wildHand(BugJoker, "2h 6h 7h 9h").Rank().Category()).To(Equal(Flush))
+		Expect(wildHand(BugJoker, "Ah Ad Ac As").Rank().Category()).To(Equal(FiveOfAKind))
+	})
+
+	It("finds the best hand using wild cards", func() {
+		board := mustParse("Kh Qh 7c 2d 3s")
+		hand, err := DeucesWild.BestHand(mustParse("Ah Jh"), board)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hand.Rank().Category()).To(Equal(RoyalStraightFlush))
+	})
+
+	It("describes what the wild cards played as", func() {
+		Expect(wildHand(JokersWild, "Ah Ad Kc Ks").Describe()).To(Equal("Full House, Aces full of Kings (Joker as an Ace)"))
+		Expect(wildHand(DeucesWild, "2c 2d 7h 7s 7c").Describe()).To(Equal("Five of a Kind, Sevens (2♣ as a Seven, 2♦ as a Seven)"))
+		Expect(wildHand(BugJoker, "5h 6d 7c 9s").Describe()).To(Equal("Straight, Nine high (Joker as an Eight)"))
+	})
+
+	It("splits wild cards by what they played as", func() {
+		made, kickers := wildHand(JokersWild, "Ah Kd 7c 4s").Split()
+		Expect(made).To(ConsistOf(Card{Ace, Heart}, *NewJoker(Black)))
+		Expect(kickers).To(HaveLen(3))
+	})
+})